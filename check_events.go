@@ -0,0 +1,58 @@
+package ordering
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/stackus/errors"
+)
+
+// CheckEvents decodes every event stored in ordering.events the way the event store
+// loads them, upcasters included, and reports each event that can no longer be decoded.
+func CheckEvents(ctx context.Context, db *sql.DB, out io.Writer) (err error) {
+	const query = `SELECT stream_id, stream_name, stream_version, event_name, event_data FROM ordering.events ORDER BY stream_id, stream_version`
+
+	_, events, err := storeRegistries()
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer func(rows *sql.Rows) {
+		if cErr := rows.Close(); cErr != nil && err == nil {
+			err = errors.Wrap(cErr, "closing event rows")
+		}
+	}(rows)
+
+	var checked, failed int
+	for rows.Next() {
+		var streamID, streamName, eventName string
+		var streamVersion int
+		var data []byte
+		if err = rows.Scan(&streamID, &streamName, &streamVersion, &eventName, &data); err != nil {
+			return err
+		}
+
+		checked++
+		if _, err := events.Deserialize(eventName, data); err != nil {
+			failed++
+			_, _ = fmt.Fprintf(out, "%s %s v%d %s: %s\n", streamName, streamID, streamVersion, eventName, err)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(out, "checked %d events, %d could not be decoded\n", checked, failed)
+
+	if failed > 0 {
+		return errors.Wrapf(errors.ErrInternal, "%d stored events could not be decoded", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/v8tix/mallbots-ordering"
+	"github.com/v8tix/mallbots-ordering/internal/config"
+	"github.com/v8tix/mallbots-ordering/internal/eventfile"
+	"github.com/v8tix/mallbots-ordering/internal/replay"
+)
+
+// checkEvents verifies that every stored event can still be decoded; run it before
+// deploying a change to the events or their upcasters.
+func checkEvents(configFile string, cfg *config.AppConfig) error {
+	return withDB(configFile, cfg, func(db *sql.DB) error {
+		return ordering.CheckEvents(context.Background(), db, os.Stdout)
+	})
+}
+
+// replayEvents rebuilds projections from the stored events, for example:
+//
+//	replay -projections orders_view -shadow
+func replayEvents(configFile string, cfg *config.AppConfig, args []string) error {
+	var projections string
+	var opts replay.Options
+
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.StringVar(&projections, "projections", ordering.Projections[0].Name, "The comma separated projections to rebuild")
+	flags.IntVar(&opts.BatchSize, "batch", 500, "The number of events projected per transaction")
+	flags.BoolVar(&opts.Resume, "resume", false, "Continue from the checkpoint of the last run")
+	flags.BoolVar(&opts.DryRun, "dry-run", false, "Read and apply the events without writing anything")
+	flags.BoolVar(&opts.Shadow, "shadow", false, "Rebuild into a shadow table and swap it in at the end")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return withDB(configFile, cfg, func(db *sql.DB) error {
+		return ordering.Replay(context.Background(), db, os.Stdout, strings.Split(projections, ","), opts)
+	})
+}
+
+// exportEvents writes order streams to JSON Lines, for example:
+//
+//	export -stream 8f1c... -o order.jsonl
+func exportEvents(configFile string, cfg *config.AppConfig, args []string) (err error) {
+	var filter eventfile.Filter
+	var from, to, output string
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.StringVar(&filter.StreamID, "stream", "", "Only export the stream with this ID")
+	flags.StringVar(&from, "from", "", "Only export streams with events at or after this RFC 3339 time, from their first event on")
+	flags.StringVar(&to, "to", "", "Only export what happened before this RFC 3339 time")
+	flags.BoolVar(&filter.Snapshots, "snapshots", true, "Export the snapshots as well")
+	flags.StringVar(&output, "o", "", "The file to write; standard output when empty")
+	if err = flags.Parse(args); err != nil {
+		return err
+	}
+
+	if filter.From, err = parseTime(from); err != nil {
+		return err
+	}
+	if filter.To, err = parseTime(to); err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if output != "" {
+		if out, err = os.Create(output); err != nil {
+			return err
+		}
+		defer func(f *os.File) {
+			cErr := f.Close()
+			if err == nil {
+				err = cErr
+			}
+		}(out)
+	}
+
+	return withDB(configFile, cfg, func(db *sql.DB) error {
+		return ordering.ExportEvents(context.Background(), db, out, filter, os.Stderr)
+	})
+}
+
+// importEvents loads a file written by export into the configured database.
+func importEvents(configFile string, cfg *config.AppConfig, args []string) (err error) {
+	var input string
+
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.StringVar(&input, "i", "", "The file to read; standard input when empty")
+	if err = flags.Parse(args); err != nil {
+		return err
+	}
+
+	in := os.Stdin
+	if input != "" {
+		if in, err = os.Open(input); err != nil {
+			return err
+		}
+		defer func(f *os.File) {
+			_ = f.Close()
+		}(in)
+	}
+
+	return withDB(configFile, cfg, func(db *sql.DB) error {
+		return ordering.ImportEvents(context.Background(), db, in, os.Stderr)
+	})
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func withDB(configFile string, cfg *config.AppConfig, fn func(db *sql.DB) error) (err error) {
+	err = config.InitConfig(configFile, cfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("pgx", cfg.PG.Conn)
+	if err != nil {
+		return err
+	}
+	defer func(db *sql.DB) {
+		err := db.Close()
+		if err != nil {
+			return
+		}
+	}(db)
+
+	return fn(db)
+}
@@ -33,7 +33,22 @@ func main() {
 
 	cfgFile := fmt.Sprintf("%s/%s", cfgDirFlag, cfgFileFlag)
 
-	if err := run(cfgFile, &cfg); err != nil {
+	var err error
+	switch command := flag.Arg(0); command {
+	case "":
+		err = run(cfgFile, &cfg)
+	case "check-events":
+		err = checkEvents(cfgFile, &cfg)
+	case "replay":
+		err = replayEvents(cfgFile, &cfg, flag.Args()[1:])
+	case "export":
+		err = exportEvents(cfgFile, &cfg, flag.Args()[1:])
+	case "import":
+		err = importEvents(cfgFile, &cfg, flag.Args()[1:])
+	default:
+		err = fmt.Errorf("unknown command %q", command)
+	}
+	if err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
 	}
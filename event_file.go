@@ -0,0 +1,67 @@
+package ordering
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/mallbots-ordering/internal/eventfile"
+)
+
+// ExportEvents writes the events, and optionally the snapshots, picked by the
+// filter to out as JSON Lines; report receives a summary.
+func ExportEvents(ctx context.Context, db *sql.DB, out io.Writer, filter eventfile.Filter, report io.Writer) error {
+	reg, eventReg, err := storeRegistries()
+	if err != nil {
+		return err
+	}
+
+	exporter := eventfile.NewExporter(db, "ordering.events", "ordering.snapshots", reg, eventReg)
+
+	events, snapshots, err := exporter.Export(ctx, out, filter)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(report, "exported %d events and %d snapshots\n", events, snapshots)
+
+	return nil
+}
+
+// ImportEvents loads a file written by ExportEvents; report receives a summary.
+// The read models are not updated; rebuild them with the replay command.
+func ImportEvents(ctx context.Context, db *sql.DB, in io.Reader, report io.Writer) error {
+	reg, events, err := storeRegistries()
+	if err != nil {
+		return err
+	}
+
+	importer := eventfile.NewImporter(db, "ordering.events", "ordering.snapshots", reg, events)
+
+	result, err := importer.Import(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(report, "imported %d events and %d snapshots; %d events were already stored\n",
+		result.Events, result.Snapshots, result.Skipped,
+	)
+
+	return nil
+}
+
+// storeRegistries returns the registry of the snapshots and the one of the events.
+func storeRegistries() (registry.Registry, registry.Registry, error) {
+	reg := registry.New()
+	if err := registrations(reg); err != nil {
+		return nil, nil, err
+	}
+	events, err := eventRegistry(reg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reg, events, nil
+}
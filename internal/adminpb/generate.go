@@ -0,0 +1,6 @@
+// Package adminpb holds the API the staff of the mall use to look into orders.
+// They extend the ordering API of mallbots-ordering-proto until that module
+// carries them.
+package adminpb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=adminpb/api.annotations.yaml adminpb/admin.api.proto
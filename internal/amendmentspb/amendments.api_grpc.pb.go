@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: amendmentspb/amendments.api.proto
+
+package amendmentspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OrderAmendmentService_AddOrderItem_FullMethodName       = "/pb.OrderAmendmentService/AddOrderItem"
+	OrderAmendmentService_RemoveOrderItem_FullMethodName    = "/pb.OrderAmendmentService/RemoveOrderItem"
+	OrderAmendmentService_ChangeItemQuantity_FullMethodName = "/pb.OrderAmendmentService/ChangeItemQuantity"
+)
+
+// OrderAmendmentServiceClient is the client API for OrderAmendmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderAmendmentServiceClient interface {
+	AddOrderItem(ctx context.Context, in *AddOrderItemRequest, opts ...grpc.CallOption) (*AddOrderItemResponse, error)
+	RemoveOrderItem(ctx context.Context, in *RemoveOrderItemRequest, opts ...grpc.CallOption) (*RemoveOrderItemResponse, error)
+	ChangeItemQuantity(ctx context.Context, in *ChangeItemQuantityRequest, opts ...grpc.CallOption) (*ChangeItemQuantityResponse, error)
+}
+
+type orderAmendmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderAmendmentServiceClient(cc grpc.ClientConnInterface) OrderAmendmentServiceClient {
+	return &orderAmendmentServiceClient{cc}
+}
+
+func (c *orderAmendmentServiceClient) AddOrderItem(ctx context.Context, in *AddOrderItemRequest, opts ...grpc.CallOption) (*AddOrderItemResponse, error) {
+	out := new(AddOrderItemResponse)
+	err := c.cc.Invoke(ctx, OrderAmendmentService_AddOrderItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderAmendmentServiceClient) RemoveOrderItem(ctx context.Context, in *RemoveOrderItemRequest, opts ...grpc.CallOption) (*RemoveOrderItemResponse, error) {
+	out := new(RemoveOrderItemResponse)
+	err := c.cc.Invoke(ctx, OrderAmendmentService_RemoveOrderItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderAmendmentServiceClient) ChangeItemQuantity(ctx context.Context, in *ChangeItemQuantityRequest, opts ...grpc.CallOption) (*ChangeItemQuantityResponse, error) {
+	out := new(ChangeItemQuantityResponse)
+	err := c.cc.Invoke(ctx, OrderAmendmentService_ChangeItemQuantity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderAmendmentServiceServer is the server API for OrderAmendmentService service.
+// All implementations must embed UnimplementedOrderAmendmentServiceServer
+// for forward compatibility
+type OrderAmendmentServiceServer interface {
+	AddOrderItem(context.Context, *AddOrderItemRequest) (*AddOrderItemResponse, error)
+	RemoveOrderItem(context.Context, *RemoveOrderItemRequest) (*RemoveOrderItemResponse, error)
+	ChangeItemQuantity(context.Context, *ChangeItemQuantityRequest) (*ChangeItemQuantityResponse, error)
+	mustEmbedUnimplementedOrderAmendmentServiceServer()
+}
+
+// UnimplementedOrderAmendmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrderAmendmentServiceServer struct {
+}
+
+func (UnimplementedOrderAmendmentServiceServer) AddOrderItem(context.Context, *AddOrderItemRequest) (*AddOrderItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrderItem not implemented")
+}
+func (UnimplementedOrderAmendmentServiceServer) RemoveOrderItem(context.Context, *RemoveOrderItemRequest) (*RemoveOrderItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrderItem not implemented")
+}
+func (UnimplementedOrderAmendmentServiceServer) ChangeItemQuantity(context.Context, *ChangeItemQuantityRequest) (*ChangeItemQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeItemQuantity not implemented")
+}
+func (UnimplementedOrderAmendmentServiceServer) mustEmbedUnimplementedOrderAmendmentServiceServer() {}
+
+// UnsafeOrderAmendmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderAmendmentServiceServer will
+// result in compilation errors.
+type UnsafeOrderAmendmentServiceServer interface {
+	mustEmbedUnimplementedOrderAmendmentServiceServer()
+}
+
+func RegisterOrderAmendmentServiceServer(s grpc.ServiceRegistrar, srv OrderAmendmentServiceServer) {
+	s.RegisterService(&OrderAmendmentService_ServiceDesc, srv)
+}
+
+func _OrderAmendmentService_AddOrderItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrderItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAmendmentServiceServer).AddOrderItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAmendmentService_AddOrderItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAmendmentServiceServer).AddOrderItem(ctx, req.(*AddOrderItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderAmendmentService_RemoveOrderItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrderItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAmendmentServiceServer).RemoveOrderItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAmendmentService_RemoveOrderItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAmendmentServiceServer).RemoveOrderItem(ctx, req.(*RemoveOrderItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderAmendmentService_ChangeItemQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeItemQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAmendmentServiceServer).ChangeItemQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAmendmentService_ChangeItemQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAmendmentServiceServer).ChangeItemQuantity(ctx, req.(*ChangeItemQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderAmendmentService_ServiceDesc is the grpc.ServiceDesc for OrderAmendmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderAmendmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.OrderAmendmentService",
+	HandlerType: (*OrderAmendmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddOrderItem",
+			Handler:    _OrderAmendmentService_AddOrderItem_Handler,
+		},
+		{
+			MethodName: "RemoveOrderItem",
+			Handler:    _OrderAmendmentService_RemoveOrderItem_Handler,
+		},
+		{
+			MethodName: "ChangeItemQuantity",
+			Handler:    _OrderAmendmentService_ChangeItemQuantity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "amendmentspb/amendments.api.proto",
+}
@@ -0,0 +1,23 @@
+package amendmentspb
+
+import (
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+)
+
+// OrderAmendedEvent is published on the order aggregate channel of
+// mallbots-ordering-proto alongside the other order events.
+const OrderAmendedEvent = "ordersapi.OrderAmended"
+
+func Registrations(reg registry.Registry) (err error) {
+	serde := serdes.NewProtoSerde(reg)
+
+	// Order events
+	if err = serde.Register(&OrderAmended{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*OrderAmended) Key() string { return OrderAmendedEvent }
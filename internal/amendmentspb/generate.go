@@ -0,0 +1,6 @@
+// Package amendmentspb holds the API and integration events for amending pending
+// orders. They extend the ordering API of mallbots-ordering-proto until that
+// module carries them.
+package amendmentspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=amendmentspb/api.annotations.yaml amendmentspb/amendments.api.proto amendmentspb/amendments.events.proto
@@ -16,14 +16,42 @@ type (
 	}
 	Commands interface {
 		CreateOrder(ctx context.Context, cmd commands.CreateOrder) error
+		AddOrderItem(ctx context.Context, cmd commands.AddOrderItem) error
+		RemoveOrderItem(ctx context.Context, cmd commands.RemoveOrderItem) error
+		ChangeItemQuantity(ctx context.Context, cmd commands.ChangeItemQuantity) error
+		RescheduleOrder(ctx context.Context, cmd commands.RescheduleOrder) error
 		RejectOrder(ctx context.Context, cmd commands.RejectOrder) error
 		ApproveOrder(ctx context.Context, cmd commands.ApproveOrder) error
+		AuthorizeCustomer(ctx context.Context, cmd commands.AuthorizeCustomer) error
+		ConfirmPayment(ctx context.Context, cmd commands.ConfirmPayment) error
+		VoidPayment(ctx context.Context, cmd commands.VoidPayment) error
 		CancelOrder(ctx context.Context, cmd commands.CancelOrder) error
+		CompleteCancellation(ctx context.Context, cmd commands.CompleteCancellation) error
+		AbortCancellation(ctx context.Context, cmd commands.AbortCancellation) error
+		ExpireOrder(ctx context.Context, cmd commands.ExpireOrder) error
+		PlaceOnHold(ctx context.Context, cmd commands.PlaceOnHold) error
+		ReleaseHold(ctx context.Context, cmd commands.ReleaseHold) error
 		ReadyOrder(ctx context.Context, cmd commands.ReadyOrder) error
 		CompleteOrder(ctx context.Context, cmd commands.CompleteOrder) error
+		ReadyFulfillmentGroup(ctx context.Context, cmd commands.ReadyFulfillmentGroup) error
+		HandOverFulfillmentGroup(ctx context.Context, cmd commands.HandOverFulfillmentGroup) error
+		RefundOrder(ctx context.Context, cmd commands.RefundOrder) error
+		RequestReturn(ctx context.Context, cmd commands.RequestReturn) error
+		ApproveReturn(ctx context.Context, cmd commands.ApproveReturn) error
+		ReceiveReturn(ctx context.Context, cmd commands.ReceiveReturn) error
+		RejectReturn(ctx context.Context, cmd commands.RejectReturn) error
 	}
 	Queries interface {
-		GetOrder(ctx context.Context, query queries.GetOrder) (*domain.Order, error)
+		GetOrder(ctx context.Context, query queries.GetOrder) (*domain.OrderView, error)
+		GetOrderDetails(ctx context.Context, query queries.GetOrderDetails) (*domain.Order, error)
+		GetAllowedTransitions(ctx context.Context, query queries.GetAllowedTransitions) ([]domain.OrderStatus, error)
+		ListReturns(ctx context.Context, query queries.ListReturns) ([]domain.Return, error)
+		ListHeldOrders(ctx context.Context, query queries.ListHeldOrders) ([]domain.HeldOrder, error)
+		GetOrderSaga(ctx context.Context, query queries.GetOrderSaga) (*domain.OrderSaga, error)
+		ListOrders(ctx context.Context, query queries.ListOrders) (*domain.OrderViewPage, error)
+		SearchOrders(ctx context.Context, query queries.SearchOrders) (*domain.OrderViewPage, error)
+		GetOrderHistory(ctx context.Context, query queries.GetOrderHistory) ([]domain.RecordedEvent, error)
+		GetOrderAsOf(ctx context.Context, query queries.GetOrderAsOf) (*domain.Order, error)
 	}
 
 	Application struct {
@@ -32,31 +60,90 @@ type (
 	}
 	appCommands struct {
 		commands.CreateOrderHandler
+		commands.AddOrderItemHandler
+		commands.RemoveOrderItemHandler
+		commands.ChangeItemQuantityHandler
+		commands.RescheduleOrderHandler
 		commands.RejectOrderHandler
 		commands.ApproveOrderHandler
+		commands.AuthorizeCustomerHandler
+		commands.ConfirmPaymentHandler
+		commands.VoidPaymentHandler
 		commands.CancelOrderHandler
+		commands.CompleteCancellationHandler
+		commands.AbortCancellationHandler
+		commands.ExpireOrderHandler
+		commands.PlaceOnHoldHandler
+		commands.ReleaseHoldHandler
 		commands.ReadyOrderHandler
 		commands.CompleteOrderHandler
+		commands.ReadyFulfillmentGroupHandler
+		commands.HandOverFulfillmentGroupHandler
+		commands.RefundOrderHandler
+		commands.RequestReturnHandler
+		commands.ApproveReturnHandler
+		commands.ReceiveReturnHandler
+		commands.RejectReturnHandler
 	}
 	appQueries struct {
 		queries.GetOrderHandler
+		queries.GetOrderDetailsHandler
+		queries.GetAllowedTransitionsHandler
+		queries.ListReturnsHandler
+		queries.ListHeldOrdersHandler
+		queries.GetOrderSagaHandler
+		queries.ListOrdersHandler
+		queries.SearchOrdersHandler
+		queries.GetOrderHistoryHandler
+		queries.GetOrderAsOfHandler
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) *Application {
+func New(orders domain.OrderRepository, promotions domain.PromotionRepository, taxes domain.TaxCalculator, categories domain.ProductCategoryRepository, slots domain.SlotCapacity,
+	risk domain.RiskScorer, held domain.HeldOrderRepository, views domain.OrderViewRepository, history domain.OrderHistoryRepository, customers domain.CustomerRepository,
+	payments domain.PaymentRepository, sagas domain.OrderSagaRepository, publisher ddd.EventPublisher[ddd.Event],
+) *Application {
 	return &Application{
 		appCommands: appCommands{
-			CreateOrderHandler:   commands.NewCreateOrderHandler(orders, publisher),
-			RejectOrderHandler:   commands.NewRejectOrderHandler(orders, publisher),
-			ApproveOrderHandler:  commands.NewApproveOrderHandler(orders, publisher),
-			CancelOrderHandler:   commands.NewCancelOrderHandler(orders, publisher),
-			ReadyOrderHandler:    commands.NewReadyOrderHandler(orders, publisher),
-			CompleteOrderHandler: commands.NewCompleteOrderHandler(orders, publisher),
+			CreateOrderHandler:              commands.NewCreateOrderHandler(orders, promotions, taxes, categories, slots, risk, publisher),
+			AddOrderItemHandler:             commands.NewAddOrderItemHandler(orders, promotions, taxes, categories, publisher),
+			RemoveOrderItemHandler:          commands.NewRemoveOrderItemHandler(orders, promotions, publisher),
+			ChangeItemQuantityHandler:       commands.NewChangeItemQuantityHandler(orders, promotions, taxes, publisher),
+			RescheduleOrderHandler:          commands.NewRescheduleOrderHandler(orders, slots, publisher),
+			RejectOrderHandler:              commands.NewRejectOrderHandler(orders, publisher),
+			ApproveOrderHandler:             commands.NewApproveOrderHandler(orders, payments, publisher),
+			AuthorizeCustomerHandler:        commands.NewAuthorizeCustomerHandler(orders, customers, publisher),
+			ConfirmPaymentHandler:           commands.NewConfirmPaymentHandler(orders, payments, publisher),
+			VoidPaymentHandler:              commands.NewVoidPaymentHandler(orders, payments),
+			CancelOrderHandler:              commands.NewCancelOrderHandler(orders, publisher),
+			CompleteCancellationHandler:     commands.NewCompleteCancellationHandler(orders, publisher),
+			AbortCancellationHandler:        commands.NewAbortCancellationHandler(orders, publisher),
+			ExpireOrderHandler:              commands.NewExpireOrderHandler(orders, publisher),
+			PlaceOnHoldHandler:              commands.NewPlaceOnHoldHandler(orders, publisher),
+			ReleaseHoldHandler:              commands.NewReleaseHoldHandler(orders, publisher),
+			ReadyOrderHandler:               commands.NewReadyOrderHandler(orders, publisher),
+			CompleteOrderHandler:            commands.NewCompleteOrderHandler(orders, publisher),
+			ReadyFulfillmentGroupHandler:    commands.NewReadyFulfillmentGroupHandler(orders, publisher),
+			HandOverFulfillmentGroupHandler: commands.NewHandOverFulfillmentGroupHandler(orders, publisher),
+			RefundOrderHandler:              commands.NewRefundOrderHandler(orders, publisher),
+			RequestReturnHandler:            commands.NewRequestReturnHandler(orders, publisher),
+			ApproveReturnHandler:            commands.NewApproveReturnHandler(orders, publisher),
+			ReceiveReturnHandler:            commands.NewReceiveReturnHandler(orders, publisher),
+			RejectReturnHandler:             commands.NewRejectReturnHandler(orders, publisher),
 		},
 		appQueries: appQueries{
-			GetOrderHandler: queries.NewGetOrderHandler(orders),
+			GetOrderHandler:              queries.NewGetOrderHandler(views, orders),
+			GetOrderDetailsHandler:       queries.NewGetOrderDetailsHandler(orders),
+			GetAllowedTransitionsHandler: queries.NewGetAllowedTransitionsHandler(orders),
+			ListReturnsHandler:           queries.NewListReturnsHandler(orders),
+			ListHeldOrdersHandler:        queries.NewListHeldOrdersHandler(held),
+			GetOrderSagaHandler:          queries.NewGetOrderSagaHandler(sagas),
+			ListOrdersHandler:            queries.NewListOrdersHandler(views),
+			SearchOrdersHandler:          queries.NewSearchOrdersHandler(views),
+			GetOrderHistoryHandler:       queries.NewGetOrderHistoryHandler(history),
+			GetOrderAsOfHandler:          queries.NewGetOrderAsOfHandler(orders),
 		},
 	}
 }
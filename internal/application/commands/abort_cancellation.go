@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type AbortCancellation struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type AbortCancellationHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewAbortCancellationHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) AbortCancellationHandler {
+	return AbortCancellationHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h AbortCancellationHandler) AbortCancellation(ctx context.Context, cmd AbortCancellation) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.AbortCancel()
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type AddOrderItem struct {
+	ID              string
+	Item            domain.Item
+	ExpectedVersion int
+}
+
+type AddOrderItemHandler struct {
+	orders     domain.OrderRepository
+	promotions domain.PromotionRepository
+	taxes      domain.TaxCalculator
+	categories domain.ProductCategoryRepository
+	publisher  ddd.EventPublisher[ddd.Event]
+}
+
+func NewAddOrderItemHandler(orders domain.OrderRepository, promotions domain.PromotionRepository, taxes domain.TaxCalculator, categories domain.ProductCategoryRepository,
+	publisher ddd.EventPublisher[ddd.Event],
+) AddOrderItemHandler {
+	return AddOrderItemHandler{
+		orders:     orders,
+		promotions: promotions,
+		taxes:      taxes,
+		categories: categories,
+		publisher:  publisher,
+	}
+}
+
+func (h AddOrderItemHandler) AddOrderItem(ctx context.Context, cmd AddOrderItem) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	promotions, err := h.promotions.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	items, err := categorize(ctx, h.categories, []domain.Item{cmd.Item})
+	if err != nil {
+		return err
+	}
+
+	taxes, err := h.taxes.Calculate(ctx, items, time.Now())
+	if err != nil {
+		return err
+	}
+
+	event, err := order.AddItem(items[0], taxes[0], promotions)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -8,28 +8,38 @@ import (
 )
 
 type ApproveOrder struct {
-	ID         string
-	ShoppingID string
+	ID              string
+	ShoppingID      string
+	ExpectedVersion int
 }
 
 type ApproveOrderHandler struct {
 	orders    domain.OrderRepository
+	payment   ConfirmPaymentHandler
 	publisher ddd.EventPublisher[ddd.Event]
 }
 
-func NewApproveOrderHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ApproveOrderHandler {
+func NewApproveOrderHandler(orders domain.OrderRepository, payments domain.PaymentRepository, publisher ddd.EventPublisher[ddd.Event]) ApproveOrderHandler {
 	return ApproveOrderHandler{
 		orders:    orders,
+		payment:   NewConfirmPaymentHandler(orders, payments, publisher),
 		publisher: publisher,
 	}
 }
 
 func (h ApproveOrderHandler) ApproveOrder(ctx context.Context, cmd ApproveOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
+	if !order.PaymentConfirmed && order.Status == domain.OrderIsPending {
+		confirmed, err := h.payment.confirm(ctx, order)
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
 	event, err := order.Approve(cmd.ShoppingID)
 	if err != nil {
 		return err
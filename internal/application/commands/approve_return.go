@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ApproveReturn struct {
+	ID              string
+	ReturnID        string
+	ExpectedVersion int
+}
+
+type ApproveReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewApproveReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ApproveReturnHandler {
+	return ApproveReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ApproveReturnHandler) ApproveReturn(ctx context.Context, cmd ApproveReturn) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ApproveReturn(cmd.ReturnID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
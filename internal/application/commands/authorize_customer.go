@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type AuthorizeCustomer struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type AuthorizeCustomerHandler struct {
+	orders    domain.OrderRepository
+	customers domain.CustomerRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewAuthorizeCustomerHandler(orders domain.OrderRepository, customers domain.CustomerRepository, publisher ddd.EventPublisher[ddd.Event]) AuthorizeCustomerHandler {
+	return AuthorizeCustomerHandler{
+		orders:    orders,
+		customers: customers,
+		publisher: publisher,
+	}
+}
+
+// AuthorizeCustomer rejects the order, giving the reason, when its customer may not
+// place it and still fails with ErrCustomerNotAuthorized so that the caller learns
+// of the refusal. Any other failure leaves the order alone, so that the
+// authorization can be retried.
+func (h AuthorizeCustomerHandler) AuthorizeCustomer(ctx context.Context, cmd AuthorizeCustomer) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	err = h.customers.Authorize(ctx, order.CustomerID)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, domain.ErrCustomerNotAuthorized) {
+		return errors.Wrap(err, "authorizing customer")
+	}
+
+	event, rejectErr := order.Reject(err.Error())
+	if rejectErr != nil {
+		return errors.Wrap(rejectErr, "rejecting unauthorized order")
+	}
+
+	if rejectErr = h.orders.Save(ctx, order); rejectErr != nil {
+		return errors.Wrap(rejectErr, "rejecting unauthorized order")
+	}
+
+	if rejectErr = h.publisher.Publish(ctx, event); rejectErr != nil {
+		return rejectErr
+	}
+
+	return err
+}
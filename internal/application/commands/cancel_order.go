@@ -8,7 +8,8 @@ import (
 )
 
 type CancelOrder struct {
-	ID string
+	ID              string
+	ExpectedVersion int
 }
 
 type CancelOrderHandler struct {
@@ -23,22 +24,31 @@ func NewCancelOrderHandler(orders domain.OrderRepository, publisher ddd.EventPub
 	}
 }
 
+// CancelOrder cancels pending orders at once. Approved orders are only moved to
+// cancelling; the cancel order saga finishes the cancellation. Orders on hold are
+// cancelled the way they would have been in the status they were held in.
 func (h CancelOrderHandler) CancelOrder(ctx context.Context, cmd CancelOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
-	event, err := order.Cancel()
+	status := order.Status
+	if status == domain.OrderIsOnHold {
+		status = order.HeldStatus
+	}
+
+	var event ddd.Event
+	switch status {
+	case domain.OrderIsApproved, domain.OrderIsInProcess:
+		event, err = order.BeginCancel()
+	default:
+		event, err = order.Cancel()
+	}
 	if err != nil {
 		return err
 	}
 
-	// // TODO CH8 remove; handled in the saga
-	// if err = h.shopping.Cancel(ctx, order.ShoppingID); err != nil {
-	// 	return err
-	// }
-
 	if err = h.orders.Save(ctx, order); err != nil {
 		return err
 	}
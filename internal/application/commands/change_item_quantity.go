@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ChangeItemQuantity struct {
+	ID              string
+	ProductID       string
+	Quantity        int
+	ExpectedVersion int
+}
+
+type ChangeItemQuantityHandler struct {
+	orders     domain.OrderRepository
+	promotions domain.PromotionRepository
+	taxes      domain.TaxCalculator
+	publisher  ddd.EventPublisher[ddd.Event]
+}
+
+func NewChangeItemQuantityHandler(orders domain.OrderRepository, promotions domain.PromotionRepository, taxes domain.TaxCalculator, publisher ddd.EventPublisher[ddd.Event]) ChangeItemQuantityHandler {
+	return ChangeItemQuantityHandler{
+		orders:     orders,
+		promotions: promotions,
+		taxes:      taxes,
+		publisher:  publisher,
+	}
+}
+
+func (h ChangeItemQuantityHandler) ChangeItemQuantity(ctx context.Context, cmd ChangeItemQuantity) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	item, exists := order.FindItem(cmd.ProductID)
+	if !exists {
+		return domain.ErrOrderItemNotFound
+	}
+	item.Quantity = cmd.Quantity
+
+	taxes, err := h.taxes.Calculate(ctx, []domain.Item{item}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	promotions, err := h.promotions.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ChangeItemQuantity(cmd.ProductID, cmd.Quantity, taxes[0], promotions)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type CompleteCancellation struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type CompleteCancellationHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewCompleteCancellationHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) CompleteCancellationHandler {
+	return CompleteCancellationHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h CompleteCancellationHandler) CompleteCancellation(ctx context.Context, cmd CompleteCancellation) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	switch order.Status {
+	case domain.OrderIsCancelled:
+		return nil
+	case domain.OrderIsCancelling:
+	default:
+		return domain.ErrOrderIsNotCancelling
+	}
+
+	event, err := order.Cancel()
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
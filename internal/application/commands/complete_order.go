@@ -8,8 +8,9 @@ import (
 )
 
 type CompleteOrder struct {
-	ID        string
-	InvoiceID string
+	ID              string
+	InvoiceID       string
+	ExpectedVersion int
 }
 
 type CompleteOrderHandler struct {
@@ -25,14 +26,14 @@ func NewCompleteOrderHandler(orders domain.OrderRepository, publisher ddd.EventP
 }
 
 func (h CompleteOrderHandler) CompleteOrder(ctx context.Context, cmd CompleteOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
 	event, err := order.Complete(cmd.InvoiceID)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	if err = h.orders.Save(ctx, order); err != nil {
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ConfirmPayment struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type ConfirmPaymentHandler struct {
+	orders    domain.OrderRepository
+	payments  domain.PaymentRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewConfirmPaymentHandler(orders domain.OrderRepository, payments domain.PaymentRepository, publisher ddd.EventPublisher[ddd.Event]) ConfirmPaymentHandler {
+	return ConfirmPaymentHandler{
+		orders:    orders,
+		payments:  payments,
+		publisher: publisher,
+	}
+}
+
+// ConfirmPayment rejects the order when the payment is declined and still fails with
+// ErrPaymentNotConfirmed, so that the caller learns the payment was not confirmed.
+// Any other failure leaves the order alone, so that the confirmation can be retried.
+func (h ConfirmPaymentHandler) ConfirmPayment(ctx context.Context, cmd ConfirmPayment) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	if order.PaymentConfirmed {
+		return nil
+	}
+
+	confirmed, err := h.confirm(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return domain.ErrPaymentNotConfirmed
+	}
+
+	return nil
+}
+
+// confirm records the outcome of confirming the payment. A declined payment rejects
+// the order instead of failing.
+func (h ConfirmPaymentHandler) confirm(ctx context.Context, order *domain.Order) (bool, error) {
+	if err := h.payments.Confirm(ctx, order.PaymentID); err != nil {
+		if !errors.Is(err, domain.ErrPaymentNotConfirmed) {
+			return false, errors.Wrap(err, "confirming payment")
+		}
+		return false, h.failPayment(ctx, order, err.Error())
+	}
+
+	event, err := order.ConfirmPayment()
+	if err != nil {
+		return false, err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return false, err
+	}
+
+	return true, h.publisher.Publish(ctx, event)
+}
+
+func (h ConfirmPaymentHandler) failPayment(ctx context.Context, order *domain.Order, reason string) error {
+	event, err := order.FailPayment(reason)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	if err = h.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+
+	if event, err = order.Reject(reason); err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
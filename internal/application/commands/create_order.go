@@ -2,6 +2,8 @@ package commands
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/stackus/errors"
 
@@ -10,38 +12,107 @@ import (
 )
 
 type CreateOrder struct {
-	ID         string
-	CustomerID string
-	PaymentID  string
-	Items      []domain.Item
+	ID              string
+	CustomerID      string
+	PaymentID       string
+	Items           []domain.Item
+	CouponCodes     []string
+	Fulfillment     domain.Fulfillment
+	ExpectedVersion int
 }
 
 type CreateOrderHandler struct {
-	orders    domain.OrderRepository
-	publisher ddd.EventPublisher[ddd.Event]
+	orders     domain.OrderRepository
+	promotions domain.PromotionRepository
+	taxes      domain.TaxCalculator
+	categories domain.ProductCategoryRepository
+	slots      domain.SlotCapacity
+	risk       domain.RiskScorer
+	publisher  ddd.EventPublisher[ddd.Event]
 }
 
-func NewCreateOrderHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) CreateOrderHandler {
+func NewCreateOrderHandler(orders domain.OrderRepository, promotions domain.PromotionRepository, taxes domain.TaxCalculator, categories domain.ProductCategoryRepository,
+	slots domain.SlotCapacity, risk domain.RiskScorer, publisher ddd.EventPublisher[ddd.Event],
+) CreateOrderHandler {
 	return CreateOrderHandler{
-		orders:    orders,
-		publisher: publisher,
+		orders:     orders,
+		promotions: promotions,
+		taxes:      taxes,
+		categories: categories,
+		slots:      slots,
+		risk:       risk,
+		publisher:  publisher,
 	}
 }
 
 func (h CreateOrderHandler) CreateOrder(ctx context.Context, cmd CreateOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
-	event, err := order.CreateOrder(cmd.ID, cmd.CustomerID, cmd.PaymentID, cmd.Items)
+	promotions, err := h.promotions.FindActive(ctx)
+	if err != nil {
+		return errors.Wrap(err, "loading promotions")
+	}
+
+	items, err := categorize(ctx, h.categories, cmd.Items)
+	if err != nil {
+		return err
+	}
+
+	taxes, err := h.taxes.Calculate(ctx, items, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "calculating taxes")
+	}
+
+	event, err := order.CreateOrder(cmd.ID, cmd.CustomerID, cmd.PaymentID, items, taxes, cmd.CouponCodes, promotions, cmd.Fulfillment)
 	if err != nil {
 		return errors.Wrap(err, "create order command")
 	}
 
+	if cmd.Fulfillment.IsScheduled() {
+		if err = h.slots.Reserve(ctx, cmd.ID, cmd.Fulfillment); err != nil {
+			return errors.Wrap(err, "reserving time slot")
+		}
+	}
+
 	if err = h.orders.Save(ctx, order); err != nil {
 		return errors.Wrap(err, "order creation")
 	}
 
-	return h.publisher.Publish(ctx, event)
+	// the hold is decided before the order is announced, so that the handlers of
+	// OrderCreated already see whether the order was held
+	hold, err := h.assessRisk(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	if hold == nil {
+		return h.publisher.Publish(ctx, event)
+	}
+
+	return h.publisher.Publish(ctx, event, hold)
+}
+
+func (h CreateOrderHandler) assessRisk(ctx context.Context, order *domain.Order) (ddd.Event, error) {
+	assessment, err := h.risk.Score(ctx, order)
+	if err != nil {
+		return nil, errors.Wrap(err, "scoring order risk")
+	}
+
+	if !assessment.Hold {
+		return nil, nil
+	}
+
+	event, err := order.PlaceOnHold(strings.Join(assessment.Reasons, "; "), assessment.Score)
+	if err != nil {
+		return nil, errors.Wrap(err, "holding risky order")
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return nil, errors.Wrap(err, "holding risky order")
+	}
+
+	return event, nil
 }
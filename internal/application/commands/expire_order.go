@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ExpireOrder struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type ExpireOrderHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewExpireOrderHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ExpireOrderHandler {
+	return ExpireOrderHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ExpireOrderHandler) ExpireOrder(ctx context.Context, cmd ExpireOrder) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.Expire()
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
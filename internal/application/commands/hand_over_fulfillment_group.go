@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type HandOverFulfillmentGroup struct {
+	ID              string
+	StoreID         string
+	ExpectedVersion int
+}
+
+type HandOverFulfillmentGroupHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewHandOverFulfillmentGroupHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) HandOverFulfillmentGroupHandler {
+	return HandOverFulfillmentGroupHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h HandOverFulfillmentGroupHandler) HandOverFulfillmentGroup(ctx context.Context, cmd HandOverFulfillmentGroup) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.HandOverFulfillmentGroup(cmd.StoreID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// loadOrder loads the order a command works on. A command with an
+// ExpectedVersion fails with ErrConcurrencyConflict unless the order is still at
+// that version; zero skips the check.
+func loadOrder(ctx context.Context, orders domain.OrderRepository, orderID string, expectedVersion int) (*domain.Order, error) {
+	order, err := orders.Load(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && order.Version() != expectedVersion {
+		return nil, errors.Wrap(domain.ErrConcurrencyConflict,
+			fmt.Sprintf("the order is at version %d, not %d", order.Version(), expectedVersion),
+		)
+	}
+
+	return order, nil
+}
+
+// categorize fills in the tax category of the items before they are taxed.
+func categorize(ctx context.Context, categories domain.ProductCategoryRepository, items []domain.Item) ([]domain.Item, error) {
+	productIDs := make([]string, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+
+	found, err := categories.Find(ctx, productIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding product categories")
+	}
+
+	categorized := make([]domain.Item, len(items))
+	for i, item := range items {
+		if category, exists := found[item.ProductID]; exists {
+			item.Category = category
+		}
+		categorized[i] = item
+	}
+
+	return categorized, nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type PlaceOnHold struct {
+	ID              string
+	Reason          string
+	ExpectedVersion int
+}
+
+type PlaceOnHoldHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewPlaceOnHoldHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) PlaceOnHoldHandler {
+	return PlaceOnHoldHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h PlaceOnHoldHandler) PlaceOnHold(ctx context.Context, cmd PlaceOnHold) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.PlaceOnHold(cmd.Reason, 0)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
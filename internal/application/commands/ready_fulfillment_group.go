@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ReadyFulfillmentGroup struct {
+	ID              string
+	StoreID         string
+	ExpectedVersion int
+}
+
+type ReadyFulfillmentGroupHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewReadyFulfillmentGroupHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ReadyFulfillmentGroupHandler {
+	return ReadyFulfillmentGroupHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ReadyFulfillmentGroupHandler) ReadyFulfillmentGroup(ctx context.Context, cmd ReadyFulfillmentGroup) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ReadyFulfillmentGroup(cmd.StoreID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -8,7 +8,8 @@ import (
 )
 
 type ReadyOrder struct {
-	ID string
+	ID              string
+	ExpectedVersion int
 }
 
 type ReadyOrderHandler struct {
@@ -24,14 +25,14 @@ func NewReadyOrderHandler(orders domain.OrderRepository, publisher ddd.EventPubl
 }
 
 func (h ReadyOrderHandler) ReadyOrder(ctx context.Context, cmd ReadyOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
 	event, err := order.Ready()
 	if err != nil {
-		return nil
+		return err
 	}
 
 	if err = h.orders.Save(ctx, order); err != nil {
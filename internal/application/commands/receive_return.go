@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// ReceiveReturn records the returned goods at the counter and refunds them.
+type ReceiveReturn struct {
+	ID              string
+	ReturnID        string
+	ExpectedVersion int
+}
+
+type ReceiveReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewReceiveReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ReceiveReturnHandler {
+	return ReceiveReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ReceiveReturnHandler) ReceiveReturn(ctx context.Context, cmd ReceiveReturn) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ReceiveReturn(cmd.ReturnID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	if err = h.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+
+	// the order may already have been refunded in full outside of the return
+	if order.RefundableAmount().IsZero() {
+		return nil
+	}
+
+	ret, _ := order.FindReturn(cmd.ReturnID)
+	if event, err = order.Refund("return "+cmd.ReturnID, ret.RefundLines()); err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
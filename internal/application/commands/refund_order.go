@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// RefundOrder refunds the whole order when Lines is empty, otherwise only the
+// quantities of the listed products.
+type RefundOrder struct {
+	ID              string
+	Reason          string
+	Lines           []domain.RefundLine
+	ExpectedVersion int
+}
+
+type RefundOrderHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRefundOrderHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) RefundOrderHandler {
+	return RefundOrderHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h RefundOrderHandler) RefundOrder(ctx context.Context, cmd RefundOrder) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.Refund(cmd.Reason, cmd.Lines)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -8,7 +8,9 @@ import (
 )
 
 type RejectOrder struct {
-	ID string
+	ID              string
+	Reason          string
+	ExpectedVersion int
 }
 
 type RejectOrderHandler struct {
@@ -23,13 +25,20 @@ func NewRejectOrderHandler(orders domain.OrderRepository, publisher ddd.EventPub
 	}
 }
 
+// RejectOrder leaves orders in a terminal status alone; the create order saga
+// rejects orders again when it compensates, also after they were rejected or
+// expired by other means.
 func (h RejectOrderHandler) RejectOrder(ctx context.Context, cmd RejectOrder) error {
-	order, err := h.orders.Load(ctx, cmd.ID)
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
 	if err != nil {
 		return err
 	}
 
-	event, err := order.Reject()
+	if order.IsTerminal() {
+		return nil
+	}
+
+	event, err := order.Reject(cmd.Reason)
 	if err != nil {
 		return err
 	}
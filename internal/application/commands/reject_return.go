@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type RejectReturn struct {
+	ID              string
+	ReturnID        string
+	Reason          string
+	ExpectedVersion int
+}
+
+type RejectReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRejectReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) RejectReturnHandler {
+	return RejectReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h RejectReturnHandler) RejectReturn(ctx context.Context, cmd RejectReturn) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.RejectReturn(cmd.ReturnID, cmd.Reason)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ReleaseHold struct {
+	ID              string
+	Note            string
+	ExpectedVersion int
+}
+
+type ReleaseHoldHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewReleaseHoldHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ReleaseHoldHandler {
+	return ReleaseHoldHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ReleaseHoldHandler) ReleaseHold(ctx context.Context, cmd ReleaseHold) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ReleaseHold(cmd.Note)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
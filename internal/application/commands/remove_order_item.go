@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type RemoveOrderItem struct {
+	ID              string
+	ProductID       string
+	ExpectedVersion int
+}
+
+type RemoveOrderItemHandler struct {
+	orders     domain.OrderRepository
+	promotions domain.PromotionRepository
+	publisher  ddd.EventPublisher[ddd.Event]
+}
+
+func NewRemoveOrderItemHandler(orders domain.OrderRepository, promotions domain.PromotionRepository, publisher ddd.EventPublisher[ddd.Event]) RemoveOrderItemHandler {
+	return RemoveOrderItemHandler{
+		orders:     orders,
+		promotions: promotions,
+		publisher:  publisher,
+	}
+}
+
+func (h RemoveOrderItemHandler) RemoveOrderItem(ctx context.Context, cmd RemoveOrderItem) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	promotions, err := h.promotions.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.RemoveItem(cmd.ProductID, promotions)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
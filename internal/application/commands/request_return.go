@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type RequestReturn struct {
+	ID              string
+	ReturnID        string
+	Reason          string
+	Lines           []domain.ReturnLine
+	ExpectedVersion int
+}
+
+type RequestReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRequestReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) RequestReturnHandler {
+	return RequestReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h RequestReturnHandler) RequestReturn(ctx context.Context, cmd RequestReturn) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.RequestReturn(cmd.ReturnID, cmd.Reason, cmd.Lines)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
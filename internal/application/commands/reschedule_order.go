@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type RescheduleOrder struct {
+	ID              string
+	Slot            domain.TimeSlot
+	ExpectedVersion int
+}
+
+type RescheduleOrderHandler struct {
+	orders    domain.OrderRepository
+	slots     domain.SlotCapacity
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRescheduleOrderHandler(orders domain.OrderRepository, slots domain.SlotCapacity, publisher ddd.EventPublisher[ddd.Event]) RescheduleOrderHandler {
+	return RescheduleOrderHandler{
+		orders:    orders,
+		slots:     slots,
+		publisher: publisher,
+	}
+}
+
+func (h RescheduleOrderHandler) RescheduleOrder(ctx context.Context, cmd RescheduleOrder) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.Reschedule(cmd.Slot)
+	if err != nil {
+		return err
+	}
+
+	fulfillment := order.Fulfillment
+	fulfillment.Slot = cmd.Slot
+	if err = h.slots.Reserve(ctx, cmd.ID, fulfillment); err != nil {
+		return errors.Wrap(err, "reserving time slot")
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
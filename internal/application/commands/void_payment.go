@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type VoidPayment struct {
+	ID              string
+	ExpectedVersion int
+}
+
+type VoidPaymentHandler struct {
+	orders   domain.OrderRepository
+	payments domain.PaymentRepository
+}
+
+func NewVoidPaymentHandler(orders domain.OrderRepository, payments domain.PaymentRepository) VoidPaymentHandler {
+	return VoidPaymentHandler{
+		orders:   orders,
+		payments: payments,
+	}
+}
+
+// VoidPayment releases a payment confirmed for an order that will not go ahead;
+// payments never confirmed are left alone.
+func (h VoidPaymentHandler) VoidPayment(ctx context.Context, cmd VoidPayment) error {
+	order, err := loadOrder(ctx, h.orders, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return err
+	}
+
+	if !order.PaymentConfirmed {
+		return nil
+	}
+
+	return h.payments.Void(ctx, order.PaymentID)
+}
@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type GetAllowedTransitions struct {
+	ID string
+}
+
+type GetAllowedTransitionsHandler struct {
+	repo domain.OrderRepository
+}
+
+func NewGetAllowedTransitionsHandler(repo domain.OrderRepository) GetAllowedTransitionsHandler {
+	return GetAllowedTransitionsHandler{repo: repo}
+}
+
+func (h GetAllowedTransitionsHandler) GetAllowedTransitions(ctx context.Context, query GetAllowedTransitions) ([]domain.OrderStatus, error) {
+	order, err := h.repo.Load(ctx, query.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get allowed transitions query")
+	}
+
+	return domain.AllowedTransitions(order), nil
+}
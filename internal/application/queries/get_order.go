@@ -13,15 +13,34 @@ type GetOrder struct {
 }
 
 type GetOrderHandler struct {
-	repo domain.OrderRepository
+	views domain.OrderViewRepository
+	repo  domain.OrderRepository
 }
 
-func NewGetOrderHandler(repo domain.OrderRepository) GetOrderHandler {
-	return GetOrderHandler{repo: repo}
+func NewGetOrderHandler(views domain.OrderViewRepository, repo domain.OrderRepository) GetOrderHandler {
+	return GetOrderHandler{
+		views: views,
+		repo:  repo,
+	}
 }
 
-func (h GetOrderHandler) GetOrder(ctx context.Context, query GetOrder) (*domain.Order, error) {
+// GetOrder reads the order view; orders recorded before the view existed are
+// rehydrated from their events until the view is rebuilt.
+func (h GetOrderHandler) GetOrder(ctx context.Context, query GetOrder) (*domain.OrderView, error) {
+	view, err := h.views.Find(ctx, query.ID)
+	if !errors.Is(err, domain.ErrOrderNotFound) {
+		return view, errors.Wrap(err, "get order query")
+	}
+
 	order, err := h.repo.Load(ctx, query.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order query")
+	}
+	if order.Status == domain.OrderUnknown {
+		return nil, errors.Wrap(domain.ErrOrderNotFound, "get order query")
+	}
+
+	orderView := domain.NewOrderView(order)
 
-	return order, errors.Wrap(err, "get order query")
+	return &orderView, nil
 }
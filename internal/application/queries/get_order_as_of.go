@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// GetOrderAsOf rebuilds an order as it was after the event at Version, or at Time;
+// with both set the earlier of the two bounds applies.
+type GetOrderAsOf struct {
+	ID      string
+	Version int
+	Time    time.Time
+}
+
+type GetOrderAsOfHandler struct {
+	repo domain.OrderRepository
+}
+
+func NewGetOrderAsOfHandler(repo domain.OrderRepository) GetOrderAsOfHandler {
+	return GetOrderAsOfHandler{repo: repo}
+}
+
+func (h GetOrderAsOfHandler) GetOrderAsOf(ctx context.Context, query GetOrderAsOf) (*domain.Order, error) {
+	order, err := h.repo.LoadAsOf(ctx, query.ID, domain.AsOf{
+		Version: query.Version,
+		Time:    query.Time,
+	})
+
+	return order, errors.Wrap(err, "get order as of query")
+}
@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type GetOrderDetails struct {
+	ID string
+}
+
+type GetOrderDetailsHandler struct {
+	repo domain.OrderRepository
+}
+
+func NewGetOrderDetailsHandler(repo domain.OrderRepository) GetOrderDetailsHandler {
+	return GetOrderDetailsHandler{repo: repo}
+}
+
+// GetOrderDetails rehydrates the order, which unlike the order view holds the
+// discounts applied to it.
+func (h GetOrderDetailsHandler) GetOrderDetails(ctx context.Context, query GetOrderDetails) (*domain.Order, error) {
+	order, err := h.repo.Load(ctx, query.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order details query")
+	}
+	if order.Status == domain.OrderUnknown {
+		return nil, errors.Wrap(domain.ErrOrderNotFound, "get order details query")
+	}
+
+	return order, nil
+}
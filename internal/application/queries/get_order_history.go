@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// GetOrderHistory lists the events of an order, oldest first. The stored payloads
+// are only returned with IncludePayloads set.
+type GetOrderHistory struct {
+	ID              string
+	IncludePayloads bool
+}
+
+type GetOrderHistoryHandler struct {
+	history domain.OrderHistoryRepository
+}
+
+func NewGetOrderHistoryHandler(history domain.OrderHistoryRepository) GetOrderHistoryHandler {
+	return GetOrderHistoryHandler{history: history}
+}
+
+func (h GetOrderHistoryHandler) GetOrderHistory(ctx context.Context, query GetOrderHistory) ([]domain.RecordedEvent, error) {
+	events, err := h.history.History(ctx, query.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order history query")
+	}
+	if len(events) == 0 {
+		return nil, errors.Wrap(domain.ErrOrderNotFound, "get order history query")
+	}
+
+	if !query.IncludePayloads {
+		for i := range events {
+			events[i].Data = nil
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type GetOrderSaga struct {
+	ID string
+}
+
+type GetOrderSagaHandler struct {
+	sagas domain.OrderSagaRepository
+}
+
+func NewGetOrderSagaHandler(sagas domain.OrderSagaRepository) GetOrderSagaHandler {
+	return GetOrderSagaHandler{sagas: sagas}
+}
+
+func (h GetOrderSagaHandler) GetOrderSaga(ctx context.Context, query GetOrderSaga) (*domain.OrderSaga, error) {
+	saga, err := h.sagas.Find(ctx, query.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order saga query")
+	}
+
+	return saga, nil
+}
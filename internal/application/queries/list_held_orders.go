@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const defaultHeldOrdersLimit = 50
+
+type ListHeldOrders struct {
+	Limit int
+}
+
+type ListHeldOrdersHandler struct {
+	held domain.HeldOrderRepository
+}
+
+func NewListHeldOrdersHandler(held domain.HeldOrderRepository) ListHeldOrdersHandler {
+	return ListHeldOrdersHandler{held: held}
+}
+
+func (h ListHeldOrdersHandler) ListHeldOrders(ctx context.Context, query ListHeldOrders) ([]domain.HeldOrder, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultHeldOrdersLimit
+	}
+
+	orders, err := h.held.FindAll(ctx, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "list held orders query")
+	}
+
+	return orders, nil
+}
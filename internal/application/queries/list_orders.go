@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	defaultOrdersLimit = 50
+	maxOrdersLimit     = 200
+)
+
+// ListOrders pages through the orders matching every set filter, newest first.
+// Cursor continues after the page it was returned with.
+type ListOrders struct {
+	CustomerID  string
+	Statuses    []domain.OrderStatus
+	StoreID     string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+	Cursor      string
+}
+
+type ListOrdersHandler struct {
+	views domain.OrderViewRepository
+}
+
+func NewListOrdersHandler(views domain.OrderViewRepository) ListOrdersHandler {
+	return ListOrdersHandler{views: views}
+}
+
+func (h ListOrdersHandler) ListOrders(ctx context.Context, query ListOrders) (*domain.OrderViewPage, error) {
+	filter := domain.OrderViewFilter{
+		CustomerID:  query.CustomerID,
+		Statuses:    query.Statuses,
+		StoreID:     query.StoreID,
+		CreatedFrom: query.CreatedFrom,
+		CreatedTo:   query.CreatedTo,
+	}
+
+	page, err := orderViewPage(query.Cursor, query.Limit, func(after *domain.OrderViewCursor, limit int) ([]domain.OrderView, error) {
+		return h.views.FindAll(ctx, filter, after, limit)
+	})
+
+	return page, errors.Wrap(err, "list orders query")
+}
+
+// orderViewPage reads one order more than the page holds to learn whether another
+// page follows.
+func orderViewPage(cursor string, limit int, find func(after *domain.OrderViewCursor, limit int) ([]domain.OrderView, error)) (*domain.OrderViewPage, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultOrdersLimit
+	case limit > maxOrdersLimit:
+		limit = maxOrdersLimit
+	}
+
+	var after *domain.OrderViewCursor
+	if cursor != "" {
+		c, err := domain.ParseOrderViewCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+
+	views, err := find(after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderViewPage{Orders: views}
+	if len(views) > limit {
+		page.Orders = views[:limit]
+		page.NextCursor = domain.NewOrderViewCursor(views[limit-1]).String()
+	}
+
+	return page, nil
+}
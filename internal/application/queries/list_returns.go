@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ListReturns struct {
+	OrderID string
+}
+
+type ListReturnsHandler struct {
+	repo domain.OrderRepository
+}
+
+func NewListReturnsHandler(repo domain.OrderRepository) ListReturnsHandler {
+	return ListReturnsHandler{repo: repo}
+}
+
+func (h ListReturnsHandler) ListReturns(ctx context.Context, query ListReturns) ([]domain.Return, error) {
+	order, err := h.repo.Load(ctx, query.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list returns query")
+	}
+
+	return order.Returns, nil
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+	"strings"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// SearchOrders pages through the orders whose product or store names contain
+// every word of Text, newest first.
+type SearchOrders struct {
+	Text   string
+	Limit  int
+	Cursor string
+}
+
+type SearchOrdersHandler struct {
+	views domain.OrderViewRepository
+}
+
+func NewSearchOrdersHandler(views domain.OrderViewRepository) SearchOrdersHandler {
+	return SearchOrdersHandler{views: views}
+}
+
+func (h SearchOrdersHandler) SearchOrders(ctx context.Context, query SearchOrders) (*domain.OrderViewPage, error) {
+	if strings.TrimSpace(query.Text) == "" {
+		return nil, domain.ErrSearchTextCannotBeBlank
+	}
+
+	page, err := orderViewPage(query.Cursor, query.Limit, func(after *domain.OrderViewCursor, limit int) ([]domain.OrderView, error) {
+		return h.views.Search(ctx, query.Text, after, limit)
+	})
+
+	return page, errors.Wrap(err, "search orders query")
+}
@@ -21,16 +21,80 @@ type (
 	}
 
 	AppConfig struct {
-		Environment     string        `json:"environment,omitempty"`
-		LogLevel        string        `json:"log_level,omitempty"`
-		PG              PGConfig      `json:"db_cfg,omitempty"`
-		Nats            NatsConfig    `json:"nats_cfg,omitempty"`
-		RPC             RPCConfig     `json:"rpc_cfg,omitempty"`
-		Web             WebConfig     `json:"web_cfg,omitempty"`
-		ShutdownTimeout time.Duration `json:"shutdown_timeout,omitempty"`
+		Environment     string            `json:"environment,omitempty"`
+		LogLevel        string            `json:"log_level,omitempty"`
+		PG              PGConfig          `json:"db_cfg,omitempty"`
+		Nats            NatsConfig        `json:"nats_cfg,omitempty"`
+		RPC             RPCConfig         `json:"rpc_cfg,omitempty"`
+		Web             WebConfig         `json:"web_cfg,omitempty"`
+		ShutdownTimeout time.Duration     `json:"shutdown_timeout,omitempty"`
+		Expiry          ExpiryConfig      `json:"expiry_cfg,omitempty"`
+		Risk            RiskConfig        `json:"risk_cfg,omitempty"`
+		Customers       ServiceConfig     `json:"customers_cfg,omitempty"`
+		Payments        ServiceConfig     `json:"payments_cfg,omitempty"`
+		Idempotency     IdempotencyConfig `json:"idempotency_cfg,omitempty"`
+		Snapshots       SnapshotConfig    `json:"snapshot_cfg,omitempty"`
 	}
 )
 
+// ExpiryConfig controls how long orders may stay pending; a zero PendingTimeout
+// leaves pending orders alone.
+type ExpiryConfig struct {
+	PendingTimeout time.Duration `json:"pending_timeout,omitempty"`
+	PollInterval   time.Duration `json:"poll_interval,omitempty"`
+	BatchSize      int           `json:"batch_size,omitempty"`
+}
+
+func (c ExpiryConfig) Enabled() bool {
+	return c.PendingTimeout > 0
+}
+
+// RiskConfig holds the thresholds of the rule based risk scorer; zero values switch
+// the matching rule off. MaxOrderTotal is in the default currency.
+type RiskConfig struct {
+	MaxOrderTotal  float64       `json:"max_order_total,omitempty"`
+	VelocityWindow time.Duration `json:"velocity_window,omitempty"`
+	VelocityLimit  int           `json:"velocity_limit,omitempty"`
+	HoldScore      int           `json:"hold_score,omitempty"`
+}
+
+// IdempotencyConfig sets how long an idempotency key sent with CreateOrder keeps
+// pointing at the order it created; zero uses DefaultIdempotencyKeyTTL. Expired
+// keys are purged every PurgeInterval, at most PurgeBatchSize at a time.
+type IdempotencyConfig struct {
+	KeyTTL         time.Duration `json:"key_ttl,omitempty"`
+	PurgeInterval  time.Duration `json:"purge_interval,omitempty"`
+	PurgeBatchSize int           `json:"purge_batch_size,omitempty"`
+}
+
+const DefaultIdempotencyKeyTTL = 24 * time.Hour
+
+func (c IdempotencyConfig) TTL() time.Duration {
+	if c.KeyTTL <= 0 {
+		return DefaultIdempotencyKeyTTL
+	}
+	return c.KeyTTL
+}
+
+// SnapshotConfig picks when order snapshots are written: every Every events, when
+// the order reaches a terminal status with OnTerminal, and when the last snapshot
+// is older than MaxAge. A zero Every uses DefaultSnapshotEvery; a zero MaxAge
+// switches the time based strategy off.
+type SnapshotConfig struct {
+	Every      int           `json:"every,omitempty"`
+	OnTerminal bool          `json:"on_terminal,omitempty"`
+	MaxAge     time.Duration `json:"max_age,omitempty"`
+}
+
+const DefaultSnapshotEvery = 50
+
+func (c SnapshotConfig) EveryEvents() int {
+	if c.Every <= 0 {
+		return DefaultSnapshotEvery
+	}
+	return c.Every
+}
+
 type RPCConfig struct {
 	Host string `json:"host,omitempty"`
 	Port string `json:"port,omitempty"`
@@ -40,6 +104,31 @@ func (c RPCConfig) Address() string {
 	return fmt.Sprintf("%s:%s", c.Host, c.Port)
 }
 
+// ServiceConfig locates another mallbots service. Only with Fake set is a local
+// stand-in used instead; it refuses only the listed blocked IDs. Retries only
+// apply to calls that failed for transient reasons.
+type ServiceConfig struct {
+	Endpoint     string        `json:"endpoint,omitempty"`
+	Timeout      time.Duration `json:"timeout,omitempty"`
+	Retries      int           `json:"retries,omitempty"`
+	RetryBackoff time.Duration `json:"retry_backoff,omitempty"`
+	Fake         bool          `json:"fake,omitempty"`
+	BlockedIDs   []string      `json:"blocked_ids,omitempty"`
+}
+
+func (c ServiceConfig) UseFake() bool {
+	return c.Fake
+}
+
+// Validate refuses a service without an endpoint unless the fake is asked for, so
+// that a missing setting cannot quietly replace the service; name is the setting.
+func (c ServiceConfig) Validate(name string) error {
+	if !c.Fake && c.Endpoint == "" {
+		return fmt.Errorf("%s: an endpoint is required unless fake is set", name)
+	}
+	return nil
+}
+
 type WebConfig struct {
 	Host string `json:"host,omitempty"`
 	Port string `json:"port,omitempty"`
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: customerspb/customers.api.proto
+
+package customerspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuthorizeCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AuthorizeCustomerRequest) Reset() {
+	*x = AuthorizeCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_customerspb_customers_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeCustomerRequest) ProtoMessage() {}
+
+func (x *AuthorizeCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customerspb_customers_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeCustomerRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customerspb_customers_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthorizeCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AuthorizeCustomerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthorizeCustomerResponse) Reset() {
+	*x = AuthorizeCustomerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_customerspb_customers_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeCustomerResponse) ProtoMessage() {}
+
+func (x *AuthorizeCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customerspb_customers_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeCustomerResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customerspb_customers_api_proto_rawDescGZIP(), []int{1}
+}
+
+var File_customerspb_customers_api_proto protoreflect.FileDescriptor
+
+var file_customerspb_customers_api_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x2a, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x66,
+	0x0a, 0x10, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x38, 0x74, 0x69, 0x78, 0x2f, 0x6d, 0x61, 0x6c, 0x6c, 0x62,
+	0x6f, 0x74, 0x73, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_customerspb_customers_api_proto_rawDescOnce sync.Once
+	file_customerspb_customers_api_proto_rawDescData = file_customerspb_customers_api_proto_rawDesc
+)
+
+func file_customerspb_customers_api_proto_rawDescGZIP() []byte {
+	file_customerspb_customers_api_proto_rawDescOnce.Do(func() {
+		file_customerspb_customers_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_customerspb_customers_api_proto_rawDescData)
+	})
+	return file_customerspb_customers_api_proto_rawDescData
+}
+
+var file_customerspb_customers_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_customerspb_customers_api_proto_goTypes = []interface{}{
+	(*AuthorizeCustomerRequest)(nil),  // 0: pb.AuthorizeCustomerRequest
+	(*AuthorizeCustomerResponse)(nil), // 1: pb.AuthorizeCustomerResponse
+}
+var file_customerspb_customers_api_proto_depIdxs = []int32{
+	0, // 0: pb.CustomersService.AuthorizeCustomer:input_type -> pb.AuthorizeCustomerRequest
+	1, // 1: pb.CustomersService.AuthorizeCustomer:output_type -> pb.AuthorizeCustomerResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_customerspb_customers_api_proto_init() }
+func file_customerspb_customers_api_proto_init() {
+	if File_customerspb_customers_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_customerspb_customers_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_customerspb_customers_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeCustomerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_customerspb_customers_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_customerspb_customers_api_proto_goTypes,
+		DependencyIndexes: file_customerspb_customers_api_proto_depIdxs,
+		MessageInfos:      file_customerspb_customers_api_proto_msgTypes,
+	}.Build()
+	File_customerspb_customers_api_proto = out.File
+	file_customerspb_customers_api_proto_rawDesc = nil
+	file_customerspb_customers_api_proto_goTypes = nil
+	file_customerspb_customers_api_proto_depIdxs = nil
+}
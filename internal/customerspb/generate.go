@@ -0,0 +1,5 @@
+// Package customerspb is the client of the customers service. No module publishes
+// its proto for this one to depend on, so the proto is kept here.
+package customerspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative customerspb/customers.api.proto
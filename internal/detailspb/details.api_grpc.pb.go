@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: detailspb/details.api.proto
+
+package detailspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OrderDetailsService_GetOrderDetails_FullMethodName = "/pb.OrderDetailsService/GetOrderDetails"
+)
+
+// OrderDetailsServiceClient is the client API for OrderDetailsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderDetailsServiceClient interface {
+	GetOrderDetails(ctx context.Context, in *GetOrderDetailsRequest, opts ...grpc.CallOption) (*GetOrderDetailsResponse, error)
+}
+
+type orderDetailsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderDetailsServiceClient(cc grpc.ClientConnInterface) OrderDetailsServiceClient {
+	return &orderDetailsServiceClient{cc}
+}
+
+func (c *orderDetailsServiceClient) GetOrderDetails(ctx context.Context, in *GetOrderDetailsRequest, opts ...grpc.CallOption) (*GetOrderDetailsResponse, error) {
+	out := new(GetOrderDetailsResponse)
+	err := c.cc.Invoke(ctx, OrderDetailsService_GetOrderDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderDetailsServiceServer is the server API for OrderDetailsService service.
+// All implementations must embed UnimplementedOrderDetailsServiceServer
+// for forward compatibility
+type OrderDetailsServiceServer interface {
+	GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error)
+	mustEmbedUnimplementedOrderDetailsServiceServer()
+}
+
+// UnimplementedOrderDetailsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrderDetailsServiceServer struct {
+}
+
+func (UnimplementedOrderDetailsServiceServer) GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderDetails not implemented")
+}
+func (UnimplementedOrderDetailsServiceServer) mustEmbedUnimplementedOrderDetailsServiceServer() {}
+
+// UnsafeOrderDetailsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderDetailsServiceServer will
+// result in compilation errors.
+type UnsafeOrderDetailsServiceServer interface {
+	mustEmbedUnimplementedOrderDetailsServiceServer()
+}
+
+func RegisterOrderDetailsServiceServer(s grpc.ServiceRegistrar, srv OrderDetailsServiceServer) {
+	s.RegisterService(&OrderDetailsService_ServiceDesc, srv)
+}
+
+func _OrderDetailsService_GetOrderDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderDetailsServiceServer).GetOrderDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderDetailsService_GetOrderDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderDetailsServiceServer).GetOrderDetails(ctx, req.(*GetOrderDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderDetailsService_ServiceDesc is the grpc.ServiceDesc for OrderDetailsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderDetailsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.OrderDetailsService",
+	HandlerType: (*OrderDetailsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrderDetails",
+			Handler:    _OrderDetailsService_GetOrderDetails_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "detailspb/details.api.proto",
+}
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: detailspb/details.events.proto
+
+package detailspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PricedOrderReadied is OrderReadied of mallbots-ordering-proto with the breakdown
+// of its total added. It is published under the OrderReadied key, so consumers
+// decoding it as OrderReadied keep working and skip the breakdown.
+type PricedOrderReadied struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string  `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PaymentId     string  `protobuf:"bytes,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Total         float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	Subtotal      float64 `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal float64 `protobuf:"fixed64,6,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	TaxTotal      float64 `protobuf:"fixed64,7,opt,name=tax_total,json=taxTotal,proto3" json:"tax_total,omitempty"`
+}
+
+func (x *PricedOrderReadied) Reset() {
+	*x = PricedOrderReadied{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_detailspb_details_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricedOrderReadied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricedOrderReadied) ProtoMessage() {}
+
+func (x *PricedOrderReadied) ProtoReflect() protoreflect.Message {
+	mi := &file_detailspb_details_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricedOrderReadied.ProtoReflect.Descriptor instead.
+func (*PricedOrderReadied) Descriptor() ([]byte, []int) {
+	return file_detailspb_details_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PricedOrderReadied) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PricedOrderReadied) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *PricedOrderReadied) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PricedOrderReadied) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PricedOrderReadied) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *PricedOrderReadied) GetDiscountTotal() float64 {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return 0
+}
+
+func (x *PricedOrderReadied) GetTaxTotal() float64 {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return 0
+}
+
+var File_detailspb_details_events_proto protoreflect.FileDescriptor
+
+var file_detailspb_details_events_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x70, 0x62, 0x2f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x76, 0x38, 0x74, 0x69, 0x78, 0x2f, 0x6d, 0x61, 0x6c, 0x6c, 0x62, 0x6f, 0x74, 0x73, 0x2d, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_detailspb_details_events_proto_rawDescOnce sync.Once
+	file_detailspb_details_events_proto_rawDescData = file_detailspb_details_events_proto_rawDesc
+)
+
+func file_detailspb_details_events_proto_rawDescGZIP() []byte {
+	file_detailspb_details_events_proto_rawDescOnce.Do(func() {
+		file_detailspb_details_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_detailspb_details_events_proto_rawDescData)
+	})
+	return file_detailspb_details_events_proto_rawDescData
+}
+
+var file_detailspb_details_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_detailspb_details_events_proto_goTypes = []interface{}{
+	(*PricedOrderReadied)(nil), // 0: pb.PricedOrderReadied
+}
+var file_detailspb_details_events_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_detailspb_details_events_proto_init() }
+func file_detailspb_details_events_proto_init() {
+	if File_detailspb_details_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_detailspb_details_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PricedOrderReadied); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_detailspb_details_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_detailspb_details_events_proto_goTypes,
+		DependencyIndexes: file_detailspb_details_events_proto_depIdxs,
+		MessageInfos:      file_detailspb_details_events_proto_msgTypes,
+	}.Build()
+	File_detailspb_details_events_proto = out.File
+	file_detailspb_details_events_proto_rawDesc = nil
+	file_detailspb_details_events_proto_goTypes = nil
+	file_detailspb_details_events_proto_depIdxs = nil
+}
@@ -0,0 +1,6 @@
+// Package detailspb holds the API and integration events carrying an order with
+// the breakdown of its total. They extend the ordering API of
+// mallbots-ordering-proto until that module carries them.
+package detailspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=detailspb/api.annotations.yaml detailspb/details.api.proto detailspb/details.events.proto
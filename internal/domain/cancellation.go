@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+var (
+	ErrOrderIsNotCancelling = errors.Wrap(errors.ErrFailedPrecondition, "the order is not being cancelled")
+)
+
+// Cancel ends a pending order straight away. Approved orders are cancelled with
+// BeginCancel, and Cancel then completes their cancellation once the shopping list
+// and the payment have been let go.
+func (o *Order) Cancel() (ddd.Event, error) {
+	if err := o.validateTransition(OrderIsCancelled); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderCanceledEvent, &OrderCanceled{
+		CustomerID: o.CustomerID,
+		PaymentID:  o.PaymentID,
+	})
+
+	return ddd.NewEvent(OrderCanceledEvent, o), nil
+}
+
+// BeginCancel starts cancelling an order that has been approved but is not yet
+// ready, also while it is on hold; the order is cancelling until the depot and
+// payments confirm.
+func (o *Order) BeginCancel() (ddd.Event, error) {
+	if err := o.validateTransition(OrderIsCancelling); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderCancellationStartedEvent, &OrderCancellationStarted{
+		CustomerID:     o.CustomerID,
+		PaymentID:      o.PaymentID,
+		ShoppingID:     o.ShoppingID,
+		CancellingFrom: o.Status,
+	})
+
+	return ddd.NewEvent(OrderCancellationStartedEvent, o), nil
+}
+
+// AbortCancel returns the order to the status it was cancelled from when one of
+// the participants refused to let go of it.
+func (o *Order) AbortCancel() (ddd.Event, error) {
+	if o.Status != OrderIsCancelling {
+		return nil, ErrOrderIsNotCancelling
+	}
+
+	o.AddEvent(OrderCancellationAbortedEvent, &OrderCancellationAborted{
+		Status: o.CancellingFrom,
+	})
+
+	return ddd.NewEvent(OrderCancellationAbortedEvent, o), nil
+}
@@ -2,8 +2,16 @@ package domain
 
 import (
 	"context"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrCustomerNotAuthorized = errors.Wrap(errors.ErrPermissionDenied, "the customer is not authorized to place orders")
 )
 
+// CustomerRepository checks customers with the customers service. Authorize returns
+// ErrCustomerNotAuthorized, possibly wrapped, for unknown or banned customers.
 type CustomerRepository interface {
 	Authorize(ctx context.Context, customerID string) error
 }
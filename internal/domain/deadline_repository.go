@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeadlineRepository keeps the moment each pending order expires. FindDue locks the
+// deadlines it returns until the surrounding transaction ends, so that only one
+// instance acts on each of them.
+type DeadlineRepository interface {
+	Schedule(ctx context.Context, orderID string, expiresAt time.Time) error
+	Remove(ctx context.Context, orderID string) error
+	FindDue(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
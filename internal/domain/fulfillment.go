@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+type FulfillmentStatus string
+
+const (
+	FulfillmentUnknown      FulfillmentStatus = ""
+	FulfillmentIsPicking    FulfillmentStatus = "picking"
+	FulfillmentIsReady      FulfillmentStatus = "ready"
+	FulfillmentIsHandedOver FulfillmentStatus = "handed-over"
+)
+
+var fulfillmentTransitions = map[FulfillmentStatus][]FulfillmentStatus{
+	FulfillmentIsPicking: {FulfillmentIsReady},
+	FulfillmentIsReady:   {FulfillmentIsHandedOver},
+}
+
+var (
+	ErrFulfillmentGroupNotFound = errors.Wrap(errors.ErrNotFound, "the order has no items from that store")
+)
+
+// FulfillmentGroup is the part of an order picked and handed over by a single
+// store. Groups are formed from the items once the order is approved.
+type FulfillmentGroup struct {
+	StoreID   string
+	StoreName string
+	Status    FulfillmentStatus
+}
+
+func (s FulfillmentStatus) String() string {
+	switch s {
+	case FulfillmentIsPicking, FulfillmentIsReady, FulfillmentIsHandedOver:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func (s FulfillmentStatus) CanTransitionTo(to FulfillmentStatus) bool {
+	for _, next := range fulfillmentTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadyFulfillmentGroup marks the items of one store as picked. The order becomes
+// ready once every group is, and is in process until then.
+func (o *Order) ReadyFulfillmentGroup(storeID string) (ddd.Event, error) {
+	if _, err := o.validateFulfillmentTransition(storeID, FulfillmentIsReady); err != nil {
+		return nil, err
+	}
+
+	groups := o.withGroupStatus(storeID, FulfillmentIsReady)
+	if err := o.validateTransition(deriveOrderStatus(groups)); err != nil {
+		return nil, err
+	}
+
+	readied := &FulfillmentGroupReadied{
+		StoreID:    storeID,
+		CustomerID: o.CustomerID,
+		PaymentID:  o.PaymentID,
+	}
+	o.AddEvent(FulfillmentGroupReadiedEvent, readied)
+
+	return ddd.NewEvent(FulfillmentGroupReadiedEvent, o, ddd.Metadata{ChangeKey: readied}), nil
+}
+
+// HandOverFulfillmentGroup records that the items of one store have reached the
+// customer; other stores may still be picking.
+func (o *Order) HandOverFulfillmentGroup(storeID string) (ddd.Event, error) {
+	if _, err := o.validateFulfillmentTransition(storeID, FulfillmentIsHandedOver); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(FulfillmentGroupHandedOverEvent, &FulfillmentGroupHandedOver{
+		StoreID:    storeID,
+		CustomerID: o.CustomerID,
+	})
+
+	return ddd.NewEvent(FulfillmentGroupHandedOverEvent, o), nil
+}
+
+func (o Order) FindFulfillmentGroup(storeID string) (FulfillmentGroup, bool) {
+	for _, group := range o.FulfillmentGroups {
+		if group.StoreID == storeID {
+			return group, true
+		}
+	}
+	return FulfillmentGroup{}, false
+}
+
+// FulfillmentGroupItems returns the items the given store is responsible for.
+func (o Order) FulfillmentGroupItems(storeID string) []Item {
+	var items []Item
+	for _, item := range o.Items {
+		if item.StoreID == storeID {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (o Order) validateFulfillmentTransition(storeID string, to FulfillmentStatus) (FulfillmentGroup, error) {
+	group, exists := o.FindFulfillmentGroup(storeID)
+	if !exists {
+		return FulfillmentGroup{}, ErrFulfillmentGroupNotFound
+	}
+
+	if !group.Status.CanTransitionTo(to) {
+		return FulfillmentGroup{}, errors.ErrFailedPrecondition.Msgf("the fulfillment group cannot transition from %s to %s", group.Status, to)
+	}
+
+	return group, nil
+}
+
+// fulfillmentGroups forms one picking group per store, in the order the stores
+// first appear in the items.
+func fulfillmentGroups(items []Item) []FulfillmentGroup {
+	var groups []FulfillmentGroup
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if seen[item.StoreID] {
+			continue
+		}
+		seen[item.StoreID] = true
+		groups = append(groups, FulfillmentGroup{
+			StoreID:   item.StoreID,
+			StoreName: item.StoreName,
+			Status:    FulfillmentIsPicking,
+		})
+	}
+	return groups
+}
+
+// deriveOrderStatus is the order status implied by its groups while being fulfilled.
+func deriveOrderStatus(groups []FulfillmentGroup) OrderStatus {
+	picking := 0
+	for _, group := range groups {
+		if group.Status == FulfillmentIsPicking {
+			picking++
+		}
+	}
+
+	switch picking {
+	case 0:
+		return OrderIsReady
+	case len(groups):
+		return OrderIsApproved
+	default:
+		return OrderIsInProcess
+	}
+}
+
+func (o Order) withGroupStatus(storeID string, status FulfillmentStatus) []FulfillmentGroup {
+	groups := make([]FulfillmentGroup, len(o.FulfillmentGroups))
+	copy(groups, o.FulfillmentGroups)
+	for i := range groups {
+		if groups[i].StoreID == storeID {
+			groups[i].Status = status
+		}
+	}
+	return groups
+}
+
+// withAllGroups moves every group not yet handed over to the status.
+func (o Order) withAllGroups(status FulfillmentStatus) []FulfillmentGroup {
+	groups := make([]FulfillmentGroup, len(o.FulfillmentGroups))
+	copy(groups, o.FulfillmentGroups)
+	for i := range groups {
+		if groups[i].Status != FulfillmentIsHandedOver {
+			groups[i].Status = status
+		}
+	}
+	return groups
+}
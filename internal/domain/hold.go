@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+var (
+	ErrHoldReasonCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the hold reason cannot be blank")
+	ErrOrderIsNotOnHold        = errors.Wrap(errors.ErrFailedPrecondition, "the order is not on hold")
+)
+
+type (
+	// RiskScorer assesses how likely a new order is to be fraudulent.
+	RiskScorer interface {
+		Score(ctx context.Context, order *Order) (RiskAssessment, error)
+	}
+
+	RiskAssessment struct {
+		Score   int
+		Reasons []string
+		Hold    bool
+	}
+
+	// HeldOrderRepository is the queue of held orders waiting on a review.
+	HeldOrderRepository interface {
+		Add(ctx context.Context, order HeldOrder) error
+		Remove(ctx context.Context, orderID string) error
+		FindAll(ctx context.Context, limit int) ([]HeldOrder, error)
+	}
+
+	HeldOrder struct {
+		OrderID    string
+		CustomerID string
+		Reason     string
+		RiskScore  int
+		Total      Money
+		HeldAt     time.Time
+	}
+)
+
+// PlaceOnHold pauses the order until it is reviewed. A held order cannot be
+// approved, readied or completed.
+func (o *Order) PlaceOnHold(reason string, riskScore int) (ddd.Event, error) {
+	if reason == "" {
+		return nil, ErrHoldReasonCannotBeBlank
+	}
+
+	if err := o.validateTransition(OrderIsOnHold); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderPlacedOnHoldEvent, &OrderPlacedOnHold{
+		CustomerID: o.CustomerID,
+		Reason:     reason,
+		RiskScore:  riskScore,
+		HeldStatus: o.Status,
+	})
+
+	return ddd.NewEvent(OrderPlacedOnHoldEvent, o), nil
+}
+
+// ReleaseHold returns the order to the status it was in when it was held.
+func (o *Order) ReleaseHold(note string) (ddd.Event, error) {
+	if o.Status != OrderIsOnHold {
+		return nil, ErrOrderIsNotOnHold
+	}
+
+	o.AddEvent(OrderHoldReleasedEvent, &OrderHoldReleased{
+		Note:   note,
+		Status: o.HeldStatus,
+	})
+
+	return ddd.NewEvent(OrderHoldReleasedEvent, o), nil
+}
+
+// RiskRules are the thresholds used by rule based risk scorers. A zero threshold
+// switches its rule off.
+type RiskRules struct {
+	MaxOrderTotal  Money
+	VelocityWindow time.Duration
+	VelocityLimit  int
+	HoldScore      int
+}
+
+const (
+	RiskPointsOrderValue   = 50
+	RiskPointsVelocity     = 40
+	RiskPointsNewPaymentID = 20
+
+	DefaultRiskHoldScore = 50
+)
+
+// Assess scores an order from what is known of it and of the customer's recent
+// orders; recentOrders counts those inside the velocity window.
+func (r RiskRules) Assess(order *Order, recentOrders int, knownPaymentID bool) RiskAssessment {
+	var assessment RiskAssessment
+
+	total := order.GetTotal()
+	if !r.MaxOrderTotal.IsZero() && total.Currency == r.MaxOrderTotal.Currency && total.Amount > r.MaxOrderTotal.Amount {
+		assessment.Score += RiskPointsOrderValue
+		assessment.Reasons = append(assessment.Reasons, "order total above "+r.MaxOrderTotal.String())
+	}
+
+	if r.VelocityLimit > 0 && recentOrders >= r.VelocityLimit {
+		assessment.Score += RiskPointsVelocity
+		assessment.Reasons = append(assessment.Reasons, "too many recent orders by the customer")
+	}
+
+	if !knownPaymentID {
+		assessment.Score += RiskPointsNewPaymentID
+		assessment.Reasons = append(assessment.Reasons, "payment not used by the customer before")
+	}
+
+	holdScore := r.HoldScore
+	if holdScore <= 0 {
+		holdScore = DefaultRiskHoldScore
+	}
+	assessment.Hold = assessment.Score >= holdScore
+
+	return assessment
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrIdempotencyKeyReused = errors.Wrap(errors.ErrInvalidArgument, "the idempotency key was already used for a different request")
+)
+
+type (
+	// IdempotencyKey ties a key chosen by the client to the order its first request
+	// created. RequestHash identifies that request, so that a key sent again with a
+	// different request can be refused.
+	IdempotencyKey struct {
+		Key         string
+		RequestHash string
+		OrderID     string
+		ExpiresAt   time.Time
+	}
+
+	// IdempotencyKeyRepository claims keys for new requests for a limited time.
+	// Claim returns the new claim when the key was free or had expired, and the
+	// earlier claim otherwise. DeleteExpired drops up to limit keys that expired
+	// before the given time and returns how many it dropped.
+	IdempotencyKeyRepository interface {
+		Claim(ctx context.Context, key, requestHash, orderID string) (IdempotencyKey, error)
+		DeleteExpired(ctx context.Context, before time.Time, limit int) (int, error)
+	}
+)
@@ -5,6 +5,11 @@ type Item struct {
 	StoreID     string
 	StoreName   string
 	ProductName string
-	Price       float64
+	Category    string
+	Price       Money
 	Quantity    int
 }
+
+func (i Item) Total() Money {
+	return i.Price.Multiply(i.Quantity)
+}
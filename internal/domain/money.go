@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// DefaultCurrency is used for amounts that arrive without a currency, such as the
+// float prices sent by the baskets service and the prices in events recorded before
+// Money was introduced.
+const DefaultCurrency = "USD"
+
+// currencyExponents holds the ISO 4217 minor unit exponent of the currencies that
+// do not use two decimal places.
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// Money is an exact amount expressed in the minor units (cents) of an ISO 4217 currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: strings.ToUpper(currency),
+	}
+}
+
+// MoneyFromFloat converts a decimal amount into minor units, rounding half away from
+// zero to the nearest minor unit of the currency.
+func MoneyFromFloat(amount float64, currency string) Money {
+	currency = strings.ToUpper(currency)
+	return Money{
+		Amount:   int64(math.Round(amount * math.Pow10(exponent(currency)))),
+		Currency: currency,
+	}
+}
+
+func (m Money) IsZero() bool { return m.Amount == 0 }
+
+// Add sums two amounts; the zero Money takes on the currency of the other amount.
+// Callers must ensure non-zero amounts share the same currency.
+func (m Money) Add(other Money) Money {
+	currency := m.Currency
+	if currency == "" {
+		currency = other.Currency
+	}
+	return Money{
+		Amount:   m.Amount + other.Amount,
+		Currency: currency,
+	}
+}
+
+func (m Money) Sub(other Money) Money {
+	return m.Add(other.Negate())
+}
+
+func (m Money) Negate() Money {
+	return Money{
+		Amount:   -m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func (m Money) Multiply(quantity int) Money {
+	return Money{
+		Amount:   m.Amount * int64(quantity),
+		Currency: m.Currency,
+	}
+}
+
+// Percent returns the given share of the amount, expressed in basis points
+// (1/100th of a percent), rounded half away from zero to the nearest minor unit.
+func (m Money) Percent(basisPoints int64) Money {
+	product := m.Amount * basisPoints
+	half := int64(5000)
+	if product < 0 {
+		half = -half
+	}
+	return Money{
+		Amount:   (product + half) / 10000,
+		Currency: m.Currency,
+	}
+}
+
+// Prorate returns numerator/denominator of the amount, rounded half away from zero.
+func (m Money) Prorate(numerator, denominator int64) Money {
+	if denominator == 0 {
+		return Money{Currency: m.Currency}
+	}
+	product := m.Amount * numerator
+	half := denominator / 2
+	if (product < 0) != (denominator < 0) {
+		half = -half
+	}
+	return Money{
+		Amount:   (product + half) / denominator,
+		Currency: m.Currency,
+	}
+}
+
+func (m Money) Min(other Money) Money {
+	if other.Amount < m.Amount {
+		return other
+	}
+	return m
+}
+
+// Float64 returns the amount in major units; it exists for the wire formats that
+// still carry doubles and must not be used for arithmetic.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / math.Pow10(exponent(m.Currency))
+}
+
+func (m Money) String() string {
+	exp := exponent(m.Currency)
+	if exp == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	unit := int64(math.Pow10(exp))
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, exp, amount%unit, m.Currency)
+}
+
+// UnmarshalJSON accepts both the Money object and the bare float amounts written by
+// earlier versions of the service, which are read in the DefaultCurrency.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if data[0] != '{' {
+		var amount float64
+		if err := json.Unmarshal(data, &amount); err != nil {
+			return err
+		}
+		*m = MoneyFromFloat(amount, DefaultCurrency)
+		return nil
+	}
+
+	type money Money
+	var v money
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = NewMoney(v.Amount, v.Currency)
+
+	return nil
+}
+
+func exponent(currency string) int {
+	if exp, exists := currencyExponents[currency]; exists {
+		return exp
+	}
+	return 2
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := map[string]struct {
+		amount   float64
+		currency string
+		want     Money
+	}{
+		"whole amount":              {amount: 12, currency: "USD", want: Money{Amount: 1200, Currency: "USD"}},
+		"cents":                     {amount: 12.34, currency: "USD", want: Money{Amount: 1234, Currency: "USD"}},
+		"half rounds up":            {amount: 0.125, currency: "USD", want: Money{Amount: 13, Currency: "USD"}},
+		"below half rounds down":    {amount: 0.124, currency: "USD", want: Money{Amount: 12, Currency: "USD"}},
+		"negative half rounds down": {amount: -0.125, currency: "USD", want: Money{Amount: -13, Currency: "USD"}},
+		"negative amount":           {amount: -12.34, currency: "USD", want: Money{Amount: -1234, Currency: "USD"}},
+		"zero exponent currency":    {amount: 1234.5, currency: "JPY", want: Money{Amount: 1235, Currency: "JPY"}},
+		"three exponent currency":   {amount: 1.2345, currency: "KWD", want: Money{Amount: 1235, Currency: "KWD"}},
+		"lower case currency":       {amount: 1, currency: "eur", want: Money{Amount: 100, Currency: "EUR"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := MoneyFromFloat(tc.amount, tc.currency); got != tc.want {
+				t.Errorf("MoneyFromFloat(%v, %q) = %+v, want %+v", tc.amount, tc.currency, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    Money
+		wantErr bool
+	}{
+		"money object":             {data: `{"Amount":1234,"Currency":"EUR"}`, want: Money{Amount: 1234, Currency: "EUR"}},
+		"lower case currency":      {data: `{"Amount":1234,"Currency":"eur"}`, want: Money{Amount: 1234, Currency: "EUR"}},
+		"negative money object":    {data: `{"Amount":-1234,"Currency":"USD"}`, want: Money{Amount: -1234, Currency: "USD"}},
+		"bare float":               {data: `12.34`, want: Money{Amount: 1234, Currency: DefaultCurrency}},
+		"bare float rounded":       {data: `0.125`, want: Money{Amount: 13, Currency: DefaultCurrency}},
+		"negative bare float":      {data: `-0.125`, want: Money{Amount: -13, Currency: DefaultCurrency}},
+		"bare integer":             {data: `12`, want: Money{Amount: 1200, Currency: DefaultCurrency}},
+		"null":                     {data: `null`},
+		"string":                   {data: `"12.34"`, wantErr: true},
+		"object with a bad amount": {data: `{"Amount":"12"}`, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got Money
+			err := json.Unmarshal([]byte(tc.data), &got)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("unmarshalled %s into %+v, want an error", tc.data, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("unmarshalled %s into %+v, want %+v", tc.data, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoney_JSONRoundTrip(t *testing.T) {
+	want := NewMoney(-1234, "EUR")
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Money
+	if err = json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round trip of %+v gave %+v", want, got)
+	}
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/stackus/errors"
 
 	"github.com/v8tix/eda/ddd"
@@ -10,21 +12,49 @@ import (
 const OrderAggregate = "ordering.Order"
 
 var (
-	ErrOrderAlreadyCreated     = errors.Wrap(errors.ErrBadRequest, "the order cannot be recreated")
+	ErrOrderNotFound           = errors.Wrap(errors.ErrNotFound, "the order was not found")
 	ErrOrderHasNoItems         = errors.Wrap(errors.ErrBadRequest, "the order has no items")
-	ErrOrderCannotBeCancelled  = errors.Wrap(errors.ErrBadRequest, "the order cannot be cancelled")
 	ErrCustomerIDCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
 	ErrPaymentIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the payment id cannot be blank")
+	ErrOrderHasMixedCurrencies = errors.Wrap(errors.ErrBadRequest, "the order items must share a single currency")
+	ErrOrderCannotBeAmended    = errors.Wrap(errors.ErrFailedPrecondition, "the order can only be amended while pending")
+	ErrOrderItemAlreadyExists  = errors.Wrap(errors.ErrAlreadyExists, "the product is already in the order")
+	ErrOrderItemNotFound       = errors.Wrap(errors.ErrNotFound, "the product is not in the order")
+	ErrQuantityMustBePositive  = errors.Wrap(errors.ErrBadRequest, "the quantity must be greater than zero")
+	ErrCannotRemoveLastItem    = errors.Wrap(errors.ErrFailedPrecondition, "the last item cannot be removed; cancel the order instead")
 )
 
 type Order struct {
 	es.Aggregate
-	CustomerID string
-	PaymentID  string
-	InvoiceID  string
-	ShoppingID string
-	Items      []Item
-	Status     OrderStatus
+	CustomerID  string
+	PaymentID   string
+	InvoiceID   string
+	ShoppingID  string
+	Items       []Item
+	CouponCodes []string
+	Discounts   []Discount
+	Taxes       []LineTax
+	Status      OrderStatus
+
+	RefundedTotal      Money
+	RefundedQuantities map[string]int
+	Returns            []Return
+
+	FulfillmentGroups []FulfillmentGroup
+	Fulfillment       Fulfillment
+
+	HeldStatus    OrderStatus
+	HoldReason    string
+	HoldRiskScore int
+
+	CancellingFrom OrderStatus
+
+	RejectionReason string
+
+	PaymentConfirmed bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 var _ interface {
@@ -40,9 +70,9 @@ func NewOrder(id string) *Order {
 
 func (Order) Key() string { return OrderAggregate }
 
-func (o *Order) CreateOrder(id, customerID, paymentID string, items []Item) (ddd.Event, error) {
-	if o.Status != OrderUnknown {
-		return nil, ErrOrderAlreadyCreated
+func (o *Order) CreateOrder(id, customerID, paymentID string, items []Item, taxes []LineTax, couponCodes []string, promotions []PromotionRule, fulfillment Fulfillment) (ddd.Event, error) {
+	if err := o.validateTransition(OrderIsPending); err != nil {
+		return nil, err
 	}
 
 	if len(items) == 0 {
@@ -57,25 +87,132 @@ func (o *Order) CreateOrder(id, customerID, paymentID string, items []Item) (ddd
 		return nil, ErrPaymentIDCannotBeBlank
 	}
 
+	for _, item := range items {
+		if item.Price.Currency != items[0].Price.Currency {
+			return nil, ErrOrderHasMixedCurrencies
+		}
+	}
+
+	// orders checked out without a fulfillment choice are scheduled later
+	if fulfillment.IsScheduled() {
+		if err := fulfillment.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	o.AddEvent(OrderCreatedEvent, &OrderCreated{
-		CustomerID: customerID,
-		PaymentID:  paymentID,
-		Items:      items,
+		CustomerID:  customerID,
+		PaymentID:   paymentID,
+		Items:       items,
+		Taxes:       taxes,
+		CouponCodes: couponCodes,
+		Discounts:   EvaluatePromotions(promotions, items, couponCodes),
+		Fulfillment: fulfillment,
 	})
 
 	return ddd.NewEvent(OrderCreatedEvent, o), nil
 }
 
-func (o *Order) Reject() (ddd.Event, error) {
-	// validate status
+func (o *Order) AddItem(item Item, tax LineTax, promotions []PromotionRule) (ddd.Event, error) {
+	if o.Status != OrderIsPending {
+		return nil, ErrOrderCannotBeAmended
+	}
 
-	o.AddEvent(OrderRejectedEvent, &OrderRejected{})
+	if item.Quantity <= 0 {
+		return nil, ErrQuantityMustBePositive
+	}
+
+	if _, exists := o.findItem(item.ProductID); exists {
+		return nil, ErrOrderItemAlreadyExists
+	}
+
+	if item.Price.Currency != o.Items[0].Price.Currency {
+		return nil, ErrOrderHasMixedCurrencies
+	}
+
+	items := append(o.copyItems(), item)
+
+	o.AddEvent(OrderItemAddedEvent, &OrderItemAdded{
+		Item:      item,
+		Tax:       tax,
+		Discounts: EvaluatePromotions(promotions, items, o.CouponCodes),
+	})
+
+	return ddd.NewEvent(OrderItemAddedEvent, o), nil
+}
+
+func (o *Order) RemoveItem(productID string, promotions []PromotionRule) (ddd.Event, error) {
+	if o.Status != OrderIsPending {
+		return nil, ErrOrderCannotBeAmended
+	}
+
+	i, exists := o.findItem(productID)
+	if !exists {
+		return nil, ErrOrderItemNotFound
+	}
+
+	if len(o.Items) == 1 {
+		return nil, ErrCannotRemoveLastItem
+	}
+
+	items := o.copyItems()
+	items = append(items[:i], items[i+1:]...)
+
+	o.AddEvent(OrderItemRemovedEvent, &OrderItemRemoved{
+		ProductID: productID,
+		Discounts: EvaluatePromotions(promotions, items, o.CouponCodes),
+	})
+
+	return ddd.NewEvent(OrderItemRemovedEvent, o), nil
+}
+
+func (o *Order) ChangeItemQuantity(productID string, quantity int, tax LineTax, promotions []PromotionRule) (ddd.Event, error) {
+	if o.Status != OrderIsPending {
+		return nil, ErrOrderCannotBeAmended
+	}
+
+	if quantity <= 0 {
+		return nil, ErrQuantityMustBePositive
+	}
+
+	i, exists := o.findItem(productID)
+	if !exists {
+		return nil, ErrOrderItemNotFound
+	}
+
+	items := o.copyItems()
+	items[i].Quantity = quantity
+
+	o.AddEvent(OrderItemQuantityChangedEvent, &OrderItemQuantityChanged{
+		ProductID: productID,
+		Quantity:  quantity,
+		Tax:       tax,
+		Discounts: EvaluatePromotions(promotions, items, o.CouponCodes),
+	})
+
+	return ddd.NewEvent(OrderItemQuantityChangedEvent, o), nil
+}
+
+func (o *Order) Reject(reason string) (ddd.Event, error) {
+	if err := o.validateTransition(OrderIsRejected); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderRejectedEvent, &OrderRejected{
+		Reason: reason,
+	})
 
 	return ddd.NewEvent(OrderRejectedEvent, o), nil
 }
 
 func (o *Order) Approve(shoppingID string) (ddd.Event, error) {
-	// validate status
+	if err := o.validateTransition(OrderIsApproved); err != nil {
+		return nil, err
+	}
+
+	if !o.PaymentConfirmed {
+		return nil, ErrPaymentMustBeConfirmed
+	}
 
 	o.AddEvent(OrderApprovedEvent, &OrderApproved{
 		ShoppingID: shoppingID,
@@ -84,25 +221,33 @@ func (o *Order) Approve(shoppingID string) (ddd.Event, error) {
 	return ddd.NewEvent(OrderApprovedEvent, o), nil
 }
 
-func (o *Order) Cancel() (ddd.Event, error) {
-	if o.Status != OrderIsPending {
-		return nil, ErrOrderCannotBeCancelled
+// Expire gives up on an order that was never approved or rejected in time.
+func (o *Order) Expire() (ddd.Event, error) {
+	if err := o.validateTransition(OrderIsExpired); err != nil {
+		return nil, err
 	}
 
-	o.AddEvent(OrderCanceledEvent, &OrderCanceled{
+	o.AddEvent(OrderExpiredEvent, &OrderExpired{
 		CustomerID: o.CustomerID,
 		PaymentID:  o.PaymentID,
 	})
-	return ddd.NewEvent(OrderCanceledEvent, o), nil
+
+	return ddd.NewEvent(OrderExpiredEvent, o), nil
 }
 
 func (o *Order) Ready() (ddd.Event, error) {
-	// validate status
+	if err := o.validateTransition(OrderIsReady); err != nil {
+		return nil, err
+	}
 
 	o.AddEvent(OrderReadiedEvent, &OrderReadied{
 		CustomerID: o.CustomerID,
 		PaymentID:  o.PaymentID,
+		Subtotal:   o.GetSubtotal(),
+		Discount:   o.GetDiscountTotal(),
+		Tax:        o.GetTaxTotal(),
 		Total:      o.GetTotal(),
+		Groups:     o.withAllGroups(FulfillmentIsReady),
 	})
 
 	return ddd.NewEvent(OrderReadiedEvent, o), nil
@@ -111,7 +256,9 @@ func (o *Order) Ready() (ddd.Event, error) {
 func (o *Order) Complete(invoiceID string) (ddd.Event, error) {
 	// validate invoice exists
 
-	// validate status
+	if err := o.validateTransition(OrderIsCompleted); err != nil {
+		return nil, err
+	}
 
 	o.AddEvent(OrderCompletedEvent, &OrderCompleted{
 		CustomerID: o.CustomerID,
@@ -121,41 +268,263 @@ func (o *Order) Complete(invoiceID string) (ddd.Event, error) {
 	return ddd.NewEvent(OrderCompletedEvent, o), nil
 }
 
-func (o Order) GetTotal() float64 {
-	var total float64
+// Refund returns money for a completed order. Without lines the whole remaining
+// amount is refunded; otherwise only the given quantities of each product.
+func (o *Order) Refund(reason string, lines []RefundLine) (ddd.Event, error) {
+	if reason == "" {
+		return nil, ErrRefundReasonCannotBeBlank
+	}
+
+	refundable := o.RefundableAmount()
+	if refundable.Amount <= 0 {
+		return nil, ErrNothingToRefund
+	}
+
+	amount := refundable
+	if len(lines) != 0 {
+		var err error
+		if lines, amount, err = o.refundLines(lines); err != nil {
+			return nil, err
+		}
+		// the last units returned settle whatever rounding left over
+		if o.refundsEverything(lines) {
+			last := len(lines) - 1
+			lines[last].Amount = lines[last].Amount.Add(refundable.Sub(amount))
+			amount = refundable
+		}
+	}
+
+	if amount.Amount > refundable.Amount {
+		return nil, ErrRefundExceedsCharge
+	}
+
+	if amount.Amount == refundable.Amount {
+		if err := o.validateTransition(OrderIsRefunded); err != nil {
+			return nil, err
+		}
+
+		refund := &OrderRefunded{
+			CustomerID: o.CustomerID,
+			PaymentID:  o.PaymentID,
+			Reason:     reason,
+			Lines:      lines,
+			Amount:     amount,
+		}
+		o.AddEvent(OrderRefundedEvent, refund)
+
+		return ddd.NewEvent(OrderRefundedEvent, o, ddd.Metadata{ChangeKey: refund}), nil
+	}
+
+	if err := o.validateTransition(OrderIsPartiallyRefunded); err != nil {
+		return nil, err
+	}
+
+	refund := &OrderPartiallyRefunded{
+		CustomerID: o.CustomerID,
+		PaymentID:  o.PaymentID,
+		Reason:     reason,
+		Lines:      lines,
+		Amount:     amount,
+	}
+	o.AddEvent(OrderPartiallyRefundedEvent, refund)
+
+	return ddd.NewEvent(OrderPartiallyRefundedEvent, o, ddd.Metadata{ChangeKey: refund}), nil
+}
+
+func (o Order) GetSubtotal() Money {
+	var subtotal Money
 
 	for _, item := range o.Items {
-		total += item.Price * float64(item.Quantity)
+		subtotal = subtotal.Add(item.Total())
+	}
+
+	return subtotal
+}
+
+func (o Order) GetDiscountTotal() Money {
+	var discount Money
+
+	for _, d := range o.Discounts {
+		discount = discount.Add(d.Amount)
+	}
+
+	return discount
+}
+
+func (o Order) GetTaxTotal() Money {
+	var tax Money
+
+	for _, t := range o.Taxes {
+		tax = tax.Add(t.Amount)
 	}
 
-	return total
+	return tax
+}
+
+func (o Order) GetTotal() Money {
+	return o.GetSubtotal().Sub(o.GetDiscountTotal()).Add(o.GetTaxTotal())
+}
+
+func (o Order) copyItems() []Item {
+	items := make([]Item, len(o.Items))
+	copy(items, o.Items)
+	return items
+}
+
+func (o Order) FindItem(productID string) (Item, bool) {
+	if i, exists := o.findItem(productID); exists {
+		return o.Items[i], true
+	}
+	return Item{}, false
+}
+
+func (o Order) findItem(productID string) (int, bool) {
+	for i, item := range o.Items {
+		if item.ProductID == productID {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (o *Order) applyRefund(lines []RefundLine, amount Money) {
+	quantities := make(map[string]int, len(o.RefundedQuantities)+len(lines))
+	for productID, quantity := range o.RefundedQuantities {
+		quantities[productID] = quantity
+	}
+	for _, line := range lines {
+		quantities[line.ProductID] += line.Quantity
+	}
+
+	o.RefundedQuantities = quantities
+	o.RefundedTotal = o.RefundedTotal.Add(amount)
 }
 
 func (o *Order) ApplyEvent(event ddd.Event) error {
+	o.UpdatedAt = event.OccurredAt()
+
 	switch payload := event.Payload().(type) {
 	case *OrderCreated:
+		o.CreatedAt = event.OccurredAt()
 		o.CustomerID = payload.CustomerID
 		o.PaymentID = payload.PaymentID
 		o.Items = payload.Items
+		o.Taxes = payload.Taxes
+		o.CouponCodes = payload.CouponCodes
+		o.Discounts = payload.Discounts
+		o.Fulfillment = payload.Fulfillment
 		o.Status = OrderIsPending
 
+	case *OrderItemAdded:
+		o.Items = append(o.copyItems(), payload.Item)
+		o.Taxes = o.withTax(payload.Tax)
+		o.Discounts = payload.Discounts
+
+	case *OrderItemRemoved:
+		if i, exists := o.findItem(payload.ProductID); exists {
+			items := o.copyItems()
+			o.Items = append(items[:i], items[i+1:]...)
+		}
+		o.Taxes = o.withoutTax(payload.ProductID)
+		o.Discounts = payload.Discounts
+
+	case *OrderItemQuantityChanged:
+		if i, exists := o.findItem(payload.ProductID); exists {
+			items := o.copyItems()
+			items[i].Quantity = payload.Quantity
+			o.Items = items
+		}
+		o.Taxes = o.withTax(payload.Tax)
+		o.Discounts = payload.Discounts
+
 	case *OrderRejected:
+		o.RejectionReason = payload.Reason
 		o.Status = OrderIsRejected
 
+	case *OrderPaymentConfirmed:
+		o.PaymentConfirmed = true
+
+	case *OrderPaymentFailed:
+		// the failure is kept in the event stream; the rejection that follows ends the order
+
 	case *OrderApproved:
 		o.ShoppingID = payload.ShoppingID
+		o.FulfillmentGroups = fulfillmentGroups(o.Items)
 		o.Status = OrderIsApproved
 
+	case *OrderCancellationStarted:
+		o.CancellingFrom = payload.CancellingFrom
+		o.Status = OrderIsCancelling
+
+	case *OrderCancellationAborted:
+		o.Status = payload.Status
+		o.CancellingFrom = OrderUnknown
+
 	case *OrderCanceled:
+		o.CancellingFrom = OrderUnknown
 		o.Status = OrderIsCancelled
 
+	case *OrderExpired:
+		o.Status = OrderIsExpired
+
+	case *OrderPlacedOnHold:
+		o.HeldStatus = payload.HeldStatus
+		o.HoldReason = payload.Reason
+		o.HoldRiskScore = payload.RiskScore
+		o.Status = OrderIsOnHold
+
+	case *OrderHoldReleased:
+		o.Status = payload.Status
+		o.HeldStatus = OrderUnknown
+		o.HoldReason = ""
+		o.HoldRiskScore = 0
+
 	case *OrderReadied:
+		o.FulfillmentGroups = o.withAllGroups(FulfillmentIsReady)
 		o.Status = OrderIsReady
 
 	case *OrderCompleted:
 		o.InvoiceID = payload.InvoiceID
+		o.FulfillmentGroups = o.withAllGroups(FulfillmentIsHandedOver)
 		o.Status = OrderIsCompleted
 
+	case *FulfillmentGroupReadied:
+		o.FulfillmentGroups = o.withGroupStatus(payload.StoreID, FulfillmentIsReady)
+		o.Status = deriveOrderStatus(o.FulfillmentGroups)
+
+	case *FulfillmentGroupHandedOver:
+		o.FulfillmentGroups = o.withGroupStatus(payload.StoreID, FulfillmentIsHandedOver)
+
+	case *OrderRescheduled:
+		o.Fulfillment.Slot = payload.Slot
+
+	case *OrderPartiallyRefunded:
+		o.applyRefund(payload.Lines, payload.Amount)
+		o.Status = OrderIsPartiallyRefunded
+
+	case *OrderRefunded:
+		o.applyRefund(payload.Lines, payload.Amount)
+		o.Status = OrderIsRefunded
+
+	case *ReturnRequested:
+		o.Returns = append(append(make([]Return, 0, len(o.Returns)+1), o.Returns...), Return{
+			ID:     payload.ReturnID,
+			Reason: payload.Reason,
+			Lines:  payload.Lines,
+			Status: ReturnIsRequested,
+		})
+
+	case *ReturnApproved:
+		o.setReturnStatus(payload.ReturnID, ReturnIsApproved)
+
+	case *ReturnReceived:
+		o.setReturnStatus(payload.ReturnID, ReturnIsReceived)
+
+	case *ReturnRejected:
+		if r, exists := o.setReturnStatus(payload.ReturnID, ReturnIsRejected); exists {
+			r.RejectionReason = payload.Reason
+		}
+
 	default:
 		return errors.ErrInternal.Msgf("%T received the event %s with unexpected payload %T", o, event.EventName(), payload)
 	}
@@ -164,12 +533,60 @@ func (o *Order) ApplyEvent(event ddd.Event) error {
 }
 func (o *Order) ApplySnapshot(snapshot es.Snapshot) error {
 	switch ss := snapshot.(type) {
+	case *OrderV3:
+		o.CustomerID = ss.CustomerID
+		o.PaymentID = ss.PaymentID
+		o.InvoiceID = ss.InvoiceID
+		o.ShoppingID = ss.ShoppingID
+		o.Items = ss.Items
+		o.Taxes = ss.Taxes
+		o.CouponCodes = ss.CouponCodes
+		o.Discounts = ss.Discounts
+		o.Status = ss.Status
+		o.RefundedTotal = ss.RefundedTotal
+		o.RefundedQuantities = ss.RefundedQuantities
+		o.Returns = ss.Returns
+		o.FulfillmentGroups = ss.FulfillmentGroups
+		o.Fulfillment = ss.Fulfillment
+		o.HeldStatus = ss.HeldStatus
+		o.HoldReason = ss.HoldReason
+		o.HoldRiskScore = ss.HoldRiskScore
+		o.CancellingFrom = ss.CancellingFrom
+		o.RejectionReason = ss.RejectionReason
+		o.PaymentConfirmed = ss.PaymentConfirmed
+		o.CreatedAt = ss.CreatedAt
+		o.UpdatedAt = ss.UpdatedAt
+
+	case *OrderV2:
+		o.CustomerID = ss.CustomerID
+		o.PaymentID = ss.PaymentID
+		o.InvoiceID = ss.InvoiceID
+		o.ShoppingID = ss.ShoppingID
+		o.Items = ss.Items
+		o.Taxes = ss.Taxes
+		o.CouponCodes = ss.CouponCodes
+		o.Discounts = ss.Discounts
+		o.Status = ss.Status
+		o.RefundedTotal = ss.RefundedTotal
+		o.RefundedQuantities = ss.RefundedQuantities
+		o.Returns = ss.Returns
+		o.FulfillmentGroups = ss.FulfillmentGroups
+		o.Fulfillment = ss.Fulfillment
+		o.HeldStatus = ss.HeldStatus
+		o.HoldReason = ss.HoldReason
+		o.HoldRiskScore = ss.HoldRiskScore
+		o.CancellingFrom = ss.CancellingFrom
+		o.RejectionReason = ss.RejectionReason
+		o.PaymentConfirmed = ss.PaymentConfirmed
+
 	case *OrderV1:
 		o.CustomerID = ss.CustomerID
 		o.PaymentID = ss.PaymentID
 		o.InvoiceID = ss.InvoiceID
 		o.ShoppingID = ss.ShoppingID
 		o.Items = ss.Items
+		o.CouponCodes = ss.CouponCodes
+		o.Discounts = ss.Discounts
 		o.Status = ss.Status
 
 	default:
@@ -180,12 +597,39 @@ func (o *Order) ApplySnapshot(snapshot es.Snapshot) error {
 }
 
 func (o *Order) ToSnapshot() es.Snapshot {
-	return &OrderV1{
-		CustomerID: o.CustomerID,
-		PaymentID:  o.PaymentID,
-		InvoiceID:  o.InvoiceID,
-		ShoppingID: o.ShoppingID,
-		Items:      o.Items,
-		Status:     o.Status,
+	return &OrderV3{
+		CustomerID:  o.CustomerID,
+		PaymentID:   o.PaymentID,
+		InvoiceID:   o.InvoiceID,
+		ShoppingID:  o.ShoppingID,
+		Items:       o.Items,
+		Taxes:       o.Taxes,
+		CouponCodes: o.CouponCodes,
+		Discounts:   o.Discounts,
+		Status:      o.Status,
+
+		RefundedTotal:      o.RefundedTotal,
+		RefundedQuantities: o.RefundedQuantities,
+		Returns:            o.Returns,
+		FulfillmentGroups:  o.FulfillmentGroups,
+		Fulfillment:        o.Fulfillment,
+		HeldStatus:         o.HeldStatus,
+		HoldReason:         o.HoldReason,
+		HoldRiskScore:      o.HoldRiskScore,
+		CancellingFrom:     o.CancellingFrom,
+		RejectionReason:    o.RejectionReason,
+		PaymentConfirmed:   o.PaymentConfirmed,
+
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+		Subtotal:      o.GetSubtotal(),
+		DiscountTotal: o.GetDiscountTotal(),
+		TaxTotal:      o.GetTaxTotal(),
+		Total:         o.GetTotal(),
 	}
 }
+
+// IsTerminal reports whether the order has reached a status it cannot leave.
+func (o Order) IsTerminal() bool {
+	return o.Status.IsTerminal()
+}
@@ -1,24 +1,81 @@
 package domain
 
 const (
-	OrderCreatedEvent   = "ordering.OrderCreated"
-	OrderRejectedEvent  = "ordering.OrderRejected"
-	OrderApprovedEvent  = "ordering.OrderApproved"
-	OrderCanceledEvent  = "ordering.OrderCanceled"
-	OrderReadiedEvent   = "ordering.OrderReadied"
-	OrderCompletedEvent = "ordering.OrderCompleted"
+	OrderCreatedEvent             = "ordering.OrderCreated"
+	OrderItemAddedEvent           = "ordering.OrderItemAdded"
+	OrderItemRemovedEvent         = "ordering.OrderItemRemoved"
+	OrderItemQuantityChangedEvent = "ordering.OrderItemQuantityChanged"
+	OrderRejectedEvent            = "ordering.OrderRejected"
+	OrderApprovedEvent            = "ordering.OrderApproved"
+	OrderCanceledEvent            = "ordering.OrderCanceled"
+	OrderReadiedEvent             = "ordering.OrderReadied"
+	OrderCompletedEvent           = "ordering.OrderCompleted"
+	OrderPartiallyRefundedEvent   = "ordering.OrderPartiallyRefunded"
+	OrderRefundedEvent            = "ordering.OrderRefunded"
+	ReturnRequestedEvent          = "ordering.ReturnRequested"
+	ReturnApprovedEvent           = "ordering.ReturnApproved"
+	ReturnReceivedEvent           = "ordering.ReturnReceived"
+	ReturnRejectedEvent           = "ordering.ReturnRejected"
+
+	FulfillmentGroupReadiedEvent    = "ordering.FulfillmentGroupReadied"
+	FulfillmentGroupHandedOverEvent = "ordering.FulfillmentGroupHandedOver"
+	OrderRescheduledEvent           = "ordering.OrderRescheduled"
+	OrderExpiredEvent               = "ordering.OrderExpired"
+	OrderPlacedOnHoldEvent          = "ordering.OrderPlacedOnHold"
+	OrderHoldReleasedEvent          = "ordering.OrderHoldReleased"
+	OrderPaymentConfirmedEvent      = "ordering.OrderPaymentConfirmed"
+	OrderPaymentFailedEvent         = "ordering.OrderPaymentFailed"
+	OrderCancellationStartedEvent   = "ordering.OrderCancellationStarted"
+	OrderCancellationAbortedEvent   = "ordering.OrderCancellationAborted"
 )
 
+// ChangeKey is the metadata under which an event returned by the order carries the
+// change it recorded, when the order alone does not tell what changed; a refund
+// carries its *OrderRefunded or *OrderPartiallyRefunded, an approved or received
+// return its *ReturnApproved or *ReturnReceived, and a readied fulfillment group
+// its *FulfillmentGroupReadied.
+const ChangeKey = "order-change"
+
 type OrderCreated struct {
-	CustomerID string
-	PaymentID  string
-	ShoppingID string
-	Items      []Item
+	CustomerID  string
+	PaymentID   string
+	ShoppingID  string
+	Items       []Item
+	Taxes       []LineTax
+	CouponCodes []string
+	Discounts   []Discount
+	Fulfillment Fulfillment
 }
 
 func (OrderCreated) Key() string { return OrderCreatedEvent }
 
-type OrderRejected struct{}
+type OrderItemAdded struct {
+	Item      Item
+	Tax       LineTax
+	Discounts []Discount
+}
+
+func (OrderItemAdded) Key() string { return OrderItemAddedEvent }
+
+type OrderItemRemoved struct {
+	ProductID string
+	Discounts []Discount
+}
+
+func (OrderItemRemoved) Key() string { return OrderItemRemovedEvent }
+
+type OrderItemQuantityChanged struct {
+	ProductID string
+	Quantity  int
+	Tax       LineTax
+	Discounts []Discount
+}
+
+func (OrderItemQuantityChanged) Key() string { return OrderItemQuantityChangedEvent }
+
+type OrderRejected struct {
+	Reason string
+}
 
 func (OrderRejected) Key() string { return OrderRejectedEvent }
 
@@ -38,7 +95,11 @@ func (OrderCanceled) Key() string { return OrderCanceledEvent }
 type OrderReadied struct {
 	CustomerID string
 	PaymentID  string
-	Total      float64
+	Subtotal   Money
+	Discount   Money
+	Tax        Money
+	Total      Money
+	Groups     []FulfillmentGroup
 }
 
 func (OrderReadied) Key() string { return OrderReadiedEvent }
@@ -49,3 +110,129 @@ type OrderCompleted struct {
 }
 
 func (OrderCompleted) Key() string { return OrderCompletedEvent }
+
+type OrderPartiallyRefunded struct {
+	CustomerID string
+	PaymentID  string
+	Reason     string
+	Lines      []RefundLine
+	Amount     Money
+}
+
+func (OrderPartiallyRefunded) Key() string { return OrderPartiallyRefundedEvent }
+
+type OrderRefunded struct {
+	CustomerID string
+	PaymentID  string
+	Reason     string
+	Lines      []RefundLine
+	Amount     Money
+}
+
+func (OrderRefunded) Key() string { return OrderRefundedEvent }
+
+type ReturnRequested struct {
+	ReturnID string
+	Reason   string
+	Lines    []ReturnLine
+}
+
+func (ReturnRequested) Key() string { return ReturnRequestedEvent }
+
+type ReturnApproved struct {
+	ReturnID   string
+	CustomerID string
+	Lines      []ReturnLine
+}
+
+func (ReturnApproved) Key() string { return ReturnApprovedEvent }
+
+type ReturnReceived struct {
+	ReturnID   string
+	CustomerID string
+	PaymentID  string
+	Lines      []ReturnLine
+}
+
+func (ReturnReceived) Key() string { return ReturnReceivedEvent }
+
+type ReturnRejected struct {
+	ReturnID string
+	Reason   string
+}
+
+func (ReturnRejected) Key() string { return ReturnRejectedEvent }
+
+type FulfillmentGroupReadied struct {
+	StoreID    string
+	CustomerID string
+	PaymentID  string
+}
+
+func (FulfillmentGroupReadied) Key() string { return FulfillmentGroupReadiedEvent }
+
+type FulfillmentGroupHandedOver struct {
+	StoreID    string
+	CustomerID string
+}
+
+func (FulfillmentGroupHandedOver) Key() string { return FulfillmentGroupHandedOverEvent }
+
+type OrderRescheduled struct {
+	CustomerID string
+	Previous   TimeSlot
+	Slot       TimeSlot
+}
+
+func (OrderRescheduled) Key() string { return OrderRescheduledEvent }
+
+type OrderExpired struct {
+	CustomerID string
+	PaymentID  string
+}
+
+func (OrderExpired) Key() string { return OrderExpiredEvent }
+
+type OrderPlacedOnHold struct {
+	CustomerID string
+	Reason     string
+	RiskScore  int
+	HeldStatus OrderStatus
+}
+
+func (OrderPlacedOnHold) Key() string { return OrderPlacedOnHoldEvent }
+
+type OrderHoldReleased struct {
+	Note   string
+	Status OrderStatus
+}
+
+func (OrderHoldReleased) Key() string { return OrderHoldReleasedEvent }
+
+type OrderPaymentConfirmed struct {
+	PaymentID string
+}
+
+func (OrderPaymentConfirmed) Key() string { return OrderPaymentConfirmedEvent }
+
+type OrderPaymentFailed struct {
+	PaymentID string
+	Reason    string
+}
+
+func (OrderPaymentFailed) Key() string { return OrderPaymentFailedEvent }
+
+type OrderCancellationStarted struct {
+	CustomerID     string
+	PaymentID      string
+	ShoppingID     string
+	CancellingFrom OrderStatus
+}
+
+func (OrderCancellationStarted) Key() string { return OrderCancellationStartedEvent }
+
+type OrderCancellationAborted struct {
+	Status OrderStatus
+}
+
+func (OrderCancellationAborted) Key() string { return OrderCancellationAbortedEvent }
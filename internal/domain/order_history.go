@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+type (
+	// OrderHistoryRepository reads the recorded events of an order, oldest first.
+	OrderHistoryRepository interface {
+		History(ctx context.Context, orderID string) ([]RecordedEvent, error)
+	}
+
+	// RecordedEvent is an event as it was stored; Data is the stored payload and
+	// Payload the payload decoded into its current schema.
+	RecordedEvent struct {
+		ID         string
+		Name       string
+		Version    int
+		OccurredAt time.Time
+		Payload    ddd.EventPayload
+		Data       []byte
+	}
+)
+
+// Summary describes the event for the people following up on an order, for
+// example "approved, shopping list 123".
+func (e RecordedEvent) Summary() string {
+	switch payload := e.Payload.(type) {
+	case *OrderCreated:
+		return fmt.Sprintf("created for customer %s with %s", payload.CustomerID, countItems(payload.Items))
+	case *OrderItemAdded:
+		return fmt.Sprintf("%d x %s added", payload.Item.Quantity, itemName(payload.Item))
+	case *OrderItemRemoved:
+		return fmt.Sprintf("product %s removed", payload.ProductID)
+	case *OrderItemQuantityChanged:
+		return fmt.Sprintf("quantity of product %s changed to %d", payload.ProductID, payload.Quantity)
+	case *OrderRejected:
+		return withReason("rejected", payload.Reason)
+	case *OrderApproved:
+		return fmt.Sprintf("approved, shopping list %s", payload.ShoppingID)
+	case *OrderCanceled:
+		return "cancelled"
+	case *OrderReadied:
+		return fmt.Sprintf("ready for pickup, total %s", payload.Total)
+	case *OrderCompleted:
+		return fmt.Sprintf("completed, invoice %s", payload.InvoiceID)
+	case *OrderPartiallyRefunded:
+		return withReason(fmt.Sprintf("partially refunded %s", payload.Amount), payload.Reason)
+	case *OrderRefunded:
+		return withReason(fmt.Sprintf("refunded %s", payload.Amount), payload.Reason)
+	case *ReturnRequested:
+		return withReason(fmt.Sprintf("return %s requested", payload.ReturnID), payload.Reason)
+	case *ReturnApproved:
+		return fmt.Sprintf("return %s approved", payload.ReturnID)
+	case *ReturnReceived:
+		return fmt.Sprintf("return %s received", payload.ReturnID)
+	case *ReturnRejected:
+		return withReason(fmt.Sprintf("return %s rejected", payload.ReturnID), payload.Reason)
+	case *FulfillmentGroupReadied:
+		return fmt.Sprintf("items of store %s ready", payload.StoreID)
+	case *FulfillmentGroupHandedOver:
+		return fmt.Sprintf("items of store %s handed over", payload.StoreID)
+	case *OrderRescheduled:
+		return fmt.Sprintf("rescheduled to %s", payload.Slot.Start.Format(time.RFC3339))
+	case *OrderExpired:
+		return "expired while pending"
+	case *OrderPlacedOnHold:
+		return withReason(fmt.Sprintf("placed on hold with risk score %d", payload.RiskScore), payload.Reason)
+	case *OrderHoldReleased:
+		return withReason(fmt.Sprintf("released from hold, back to %s", payload.Status), payload.Note)
+	case *OrderPaymentConfirmed:
+		return fmt.Sprintf("payment %s confirmed", payload.PaymentID)
+	case *OrderPaymentFailed:
+		return withReason(fmt.Sprintf("payment %s failed", payload.PaymentID), payload.Reason)
+	case *OrderCancellationStarted:
+		return fmt.Sprintf("cancellation started while %s", payload.CancellingFrom)
+	case *OrderCancellationAborted:
+		return fmt.Sprintf("cancellation aborted, back to %s", payload.Status)
+	default:
+		return strings.TrimPrefix(e.Name, "ordering.")
+	}
+}
+
+func countItems(items []Item) string {
+	var count int
+	for _, item := range items {
+		count += item.Quantity
+	}
+	if count == 1 {
+		return "1 item"
+	}
+	return fmt.Sprintf("%d items", count)
+}
+
+func itemName(item Item) string {
+	if item.ProductName != "" {
+		return item.ProductName
+	}
+	return "product " + item.ProductID
+}
+
+func withReason(summary, reason string) string {
+	if reason == "" {
+		return summary
+	}
+	return summary + ": " + reason
+}
@@ -2,9 +2,35 @@ package domain
 
 import (
 	"context"
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrConcurrencyConflict = errors.Wrap(errors.ErrAborted, "the order was changed by someone else; reload it and try again")
+	ErrAsOfBoundRequired   = errors.Wrap(errors.ErrBadRequest, "a version or a time to load the order as of is required")
 )
 
+// OrderRepository saves orders with optimistic concurrency; Save returns
+// ErrConcurrencyConflict, possibly wrapped, when the order was changed since it was
+// loaded.
+//
+// LoadAsOf rebuilds the order as it was at the bound, from the events recorded up
+// to it; it returns ErrOrderNotFound when the order did not exist yet.
 type OrderRepository interface {
 	Load(ctx context.Context, orderID string) (*Order, error)
+	LoadAsOf(ctx context.Context, orderID string, bound AsOf) (*Order, error)
 	Save(ctx context.Context, order *Order) error
 }
+
+// AsOf bounds the events an order is rebuilt from to the events up to Version and
+// that occurred at or before Time; a zero field does not bound the events.
+type AsOf struct {
+	Version int
+	Time    time.Time
+}
+
+func (b AsOf) IsZero() bool {
+	return b.Version == 0 && b.Time.IsZero()
+}
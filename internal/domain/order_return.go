@@ -0,0 +1,250 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnUnknown     ReturnStatus = ""
+	ReturnIsRequested ReturnStatus = "requested"
+	ReturnIsApproved  ReturnStatus = "approved"
+	ReturnIsReceived  ReturnStatus = "received"
+	ReturnIsRejected  ReturnStatus = "rejected"
+)
+
+var returnTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnUnknown:     {ReturnIsRequested},
+	ReturnIsRequested: {ReturnIsApproved, ReturnIsRejected},
+	ReturnIsApproved:  {ReturnIsReceived, ReturnIsRejected},
+}
+
+var (
+	ErrReturnIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the return id cannot be blank")
+	ErrReturnHasNoLines       = errors.Wrap(errors.ErrBadRequest, "the return has no lines")
+	ErrReturnAlreadyExists    = errors.Wrap(errors.ErrAlreadyExists, "the return already exists")
+	ErrReturnNotFound         = errors.Wrap(errors.ErrNotFound, "the return was not found")
+	ErrOrderCannotBeReturned  = errors.Wrap(errors.ErrFailedPrecondition, "only picked up orders can have items returned")
+	ErrReturnQuantityExceeded = errors.Wrap(errors.ErrFailedPrecondition, "the return quantity exceeds the quantity not already being returned or refunded")
+)
+
+// Return is a customer request to send back items of a picked up order; it lives
+// inside the Order aggregate and is identified by an id unique to the order.
+type Return struct {
+	ID              string
+	Reason          string
+	Lines           []ReturnLine
+	Status          ReturnStatus
+	RejectionReason string
+}
+
+type ReturnLine struct {
+	ProductID string
+	Quantity  int
+}
+
+func (s ReturnStatus) String() string {
+	switch s {
+	case ReturnIsRequested, ReturnIsApproved, ReturnIsReceived, ReturnIsRejected:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func (s ReturnStatus) CanTransitionTo(to ReturnStatus) bool {
+	for _, next := range returnTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// RefundLines converts the returned quantities into the lines of a refund.
+func (r Return) RefundLines() []RefundLine {
+	lines := make([]RefundLine, len(r.Lines))
+	for i, line := range r.Lines {
+		lines[i] = RefundLine{
+			ProductID: line.ProductID,
+			Quantity:  line.Quantity,
+		}
+	}
+	return lines
+}
+
+func (o *Order) RequestReturn(returnID, reason string, lines []ReturnLine) (ddd.Event, error) {
+	if o.Status != OrderIsCompleted && o.Status != OrderIsPartiallyRefunded {
+		return nil, ErrOrderCannotBeReturned
+	}
+
+	if returnID == "" {
+		return nil, ErrReturnIDCannotBeBlank
+	}
+
+	if _, exists := o.findReturn(returnID); exists {
+		return nil, ErrReturnAlreadyExists
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrReturnHasNoLines
+	}
+
+	requested := make(map[string]int, len(lines))
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, ErrQuantityMustBePositive
+		}
+
+		item, exists := o.FindItem(line.ProductID)
+		if !exists {
+			return nil, ErrOrderItemNotFound
+		}
+
+		requested[line.ProductID] += line.Quantity
+		if o.unreturnableQuantity(line.ProductID)+requested[line.ProductID] > item.Quantity {
+			return nil, ErrReturnQuantityExceeded
+		}
+	}
+
+	o.AddEvent(ReturnRequestedEvent, &ReturnRequested{
+		ReturnID: returnID,
+		Reason:   reason,
+		Lines:    lines,
+	})
+
+	return ddd.NewEvent(ReturnRequestedEvent, o), nil
+}
+
+func (o *Order) ApproveReturn(returnID string) (ddd.Event, error) {
+	r, err := o.validateReturnTransition(returnID, ReturnIsApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := &ReturnApproved{
+		ReturnID:   returnID,
+		CustomerID: o.CustomerID,
+		Lines:      r.Lines,
+	}
+	o.AddEvent(ReturnApprovedEvent, approved)
+
+	return ddd.NewEvent(ReturnApprovedEvent, o, ddd.Metadata{ChangeKey: approved}), nil
+}
+
+func (o *Order) ReceiveReturn(returnID string) (ddd.Event, error) {
+	r, err := o.validateReturnTransition(returnID, ReturnIsReceived)
+	if err != nil {
+		return nil, err
+	}
+
+	received := &ReturnReceived{
+		ReturnID:   returnID,
+		CustomerID: o.CustomerID,
+		PaymentID:  o.PaymentID,
+		Lines:      r.Lines,
+	}
+	o.AddEvent(ReturnReceivedEvent, received)
+
+	return ddd.NewEvent(ReturnReceivedEvent, o, ddd.Metadata{ChangeKey: received}), nil
+}
+
+func (o *Order) RejectReturn(returnID, reason string) (ddd.Event, error) {
+	if _, err := o.validateReturnTransition(returnID, ReturnIsRejected); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(ReturnRejectedEvent, &ReturnRejected{
+		ReturnID: returnID,
+		Reason:   reason,
+	})
+
+	return ddd.NewEvent(ReturnRejectedEvent, o), nil
+}
+
+func (o Order) FindReturn(returnID string) (Return, bool) {
+	if i, exists := o.findReturn(returnID); exists {
+		return o.Returns[i], true
+	}
+	return Return{}, false
+}
+
+func (o Order) findReturn(returnID string) (int, bool) {
+	for i, r := range o.Returns {
+		if r.ID == returnID {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (o Order) validateReturnTransition(returnID string, to ReturnStatus) (Return, error) {
+	r, exists := o.FindReturn(returnID)
+	if !exists {
+		return Return{}, ErrReturnNotFound
+	}
+
+	if !r.Status.CanTransitionTo(to) {
+		return Return{}, errors.ErrFailedPrecondition.Msgf("the return cannot transition from %s to %s", r.Status, to)
+	}
+
+	return r, nil
+}
+
+// returningQuantity is the quantity of a product covered by returns that have not been rejected.
+func (o Order) returningQuantity(productID string) int {
+	var quantity int
+	for _, r := range o.Returns {
+		if r.Status == ReturnIsRejected {
+			continue
+		}
+		for _, line := range r.Lines {
+			if line.ProductID == productID {
+				quantity += line.Quantity
+			}
+		}
+	}
+	return quantity
+}
+
+// unreturnableQuantity is the quantity of a product that cannot be returned again:
+// the quantity covered by returns that have not been rejected, and the quantity
+// refunded outside of a return. Receiving a return refunds its lines, unless the
+// order was refunded in full before, so the received quantity is only counted once.
+func (o Order) unreturnableQuantity(productID string) int {
+	var received int
+	for _, r := range o.Returns {
+		if r.Status != ReturnIsReceived {
+			continue
+		}
+		for _, line := range r.Lines {
+			if line.ProductID == productID {
+				received += line.Quantity
+			}
+		}
+	}
+
+	refunded := o.RefundedQuantities[productID] - received
+	if refunded < 0 {
+		refunded = 0
+	}
+
+	return o.returningQuantity(productID) + refunded
+}
+
+func (o *Order) setReturnStatus(returnID string, status ReturnStatus) (*Return, bool) {
+	i, exists := o.findReturn(returnID)
+	if !exists {
+		return nil, false
+	}
+
+	returns := make([]Return, len(o.Returns))
+	copy(returns, o.Returns)
+	returns[i].Status = status
+	o.Returns = returns
+
+	return &o.Returns[i], true
+}
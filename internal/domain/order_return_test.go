@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stackus/errors"
+)
+
+func TestOrder_RequestReturn(t *testing.T) {
+	tests := map[string]struct {
+		refunded map[string]int
+		returns  []Return
+		quantity int
+		wantErr  error
+	}{
+		"nothing returned or refunded": {
+			quantity: 2,
+		},
+		"more than bought": {
+			quantity: 3,
+			wantErr:  ErrReturnQuantityExceeded,
+		},
+		"already being returned": {
+			returns:  []Return{{ID: "return-1", Lines: []ReturnLine{{ProductID: "product-1", Quantity: 1}}, Status: ReturnIsApproved}},
+			quantity: 2,
+			wantErr:  ErrReturnQuantityExceeded,
+		},
+		"rejected return": {
+			returns:  []Return{{ID: "return-1", Lines: []ReturnLine{{ProductID: "product-1", Quantity: 2}}, Status: ReturnIsRejected}},
+			quantity: 2,
+		},
+		"refunded outside of a return": {
+			refunded: map[string]int{"product-1": 1},
+			quantity: 2,
+			wantErr:  ErrReturnQuantityExceeded,
+		},
+		"rest of a partly refunded item": {
+			refunded: map[string]int{"product-1": 1},
+			quantity: 1,
+		},
+		"refunded by a received return": {
+			refunded: map[string]int{"product-1": 1},
+			returns:  []Return{{ID: "return-1", Lines: []ReturnLine{{ProductID: "product-1", Quantity: 1}}, Status: ReturnIsReceived}},
+			quantity: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := NewOrder("order-1")
+			order.Status = OrderIsPartiallyRefunded
+			order.Items = []Item{{ProductID: "product-1", Price: NewMoney(1000, DefaultCurrency), Quantity: 2}}
+			order.RefundedQuantities = tc.refunded
+			order.Returns = tc.returns
+
+			_, err := order.RequestReturn("return-2", "damaged", []ReturnLine{{ProductID: "product-1", Quantity: tc.quantity}})
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("err = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
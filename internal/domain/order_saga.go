@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrOrderSagaNotFound = errors.Wrap(errors.ErrNotFound, "no saga has been started for the order")
+)
+
+// OrderSaga is the progress of the saga creating an order. Step names the step
+// last executed, or being compensated when Compensating is set; RefusalReason is
+// why the step that set off the compensation was refused.
+type OrderSaga struct {
+	OrderID       string
+	Step          string
+	Done          bool
+	Compensating  bool
+	RefusalReason string
+}
+
+// OrderSagaRepository reads the state kept by the create order saga; it returns
+// ErrOrderSagaNotFound for orders the saga never started on.
+type OrderSagaRepository interface {
+	Find(ctx context.Context, orderID string) (*OrderSaga, error)
+}
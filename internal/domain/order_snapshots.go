@@ -1,12 +1,79 @@
 package domain
 
+import (
+	"time"
+)
+
 type OrderV1 struct {
-	CustomerID string
-	PaymentID  string
-	InvoiceID  string
-	ShoppingID string
-	Items      []Item
-	Status     OrderStatus
+	CustomerID  string
+	PaymentID   string
+	InvoiceID   string
+	ShoppingID  string
+	Items       []Item
+	CouponCodes []string
+	Discounts   []Discount
+	Status      OrderStatus
 }
 
 func (OrderV1) SnapshotName() string { return "ordering.OrderV1" }
+
+type OrderV2 struct {
+	CustomerID  string
+	PaymentID   string
+	InvoiceID   string
+	ShoppingID  string
+	Items       []Item
+	Taxes       []LineTax
+	CouponCodes []string
+	Discounts   []Discount
+	Status      OrderStatus
+
+	RefundedTotal      Money
+	RefundedQuantities map[string]int
+	Returns            []Return
+	FulfillmentGroups  []FulfillmentGroup
+	Fulfillment        Fulfillment
+	HeldStatus         OrderStatus
+	HoldReason         string
+	HoldRiskScore      int
+	CancellingFrom     OrderStatus
+	RejectionReason    string
+	PaymentConfirmed   bool
+}
+
+func (OrderV2) SnapshotName() string { return "ordering.OrderV2" }
+
+// OrderV3 adds the timestamps of the order and its totals to OrderV2. The totals are
+// derived from the items and only kept for the readers of the snapshot table.
+type OrderV3 struct {
+	CustomerID  string
+	PaymentID   string
+	InvoiceID   string
+	ShoppingID  string
+	Items       []Item
+	Taxes       []LineTax
+	CouponCodes []string
+	Discounts   []Discount
+	Status      OrderStatus
+
+	RefundedTotal      Money
+	RefundedQuantities map[string]int
+	Returns            []Return
+	FulfillmentGroups  []FulfillmentGroup
+	Fulfillment        Fulfillment
+	HeldStatus         OrderStatus
+	HoldReason         string
+	HoldRiskScore      int
+	CancellingFrom     OrderStatus
+	RejectionReason    string
+	PaymentConfirmed   bool
+
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Subtotal      Money
+	DiscountTotal Money
+	TaxTotal      Money
+	Total         Money
+}
+
+func (OrderV3) SnapshotName() string { return "ordering.OrderV3" }
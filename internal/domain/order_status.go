@@ -3,19 +3,26 @@ package domain
 type OrderStatus string
 
 const (
-	OrderUnknown     OrderStatus = ""
-	OrderIsPending   OrderStatus = "pending"
-	OrderIsRejected  OrderStatus = "rejected"
-	OrderIsApproved  OrderStatus = "approved"
-	OrderIsInProcess OrderStatus = "in-progress"
-	OrderIsReady     OrderStatus = "ready"
-	OrderIsCompleted OrderStatus = "completed"
-	OrderIsCancelled OrderStatus = "cancelled"
+	OrderUnknown      OrderStatus = ""
+	OrderIsPending    OrderStatus = "pending"
+	OrderIsRejected   OrderStatus = "rejected"
+	OrderIsApproved   OrderStatus = "approved"
+	OrderIsInProcess  OrderStatus = "in-progress"
+	OrderIsReady      OrderStatus = "ready"
+	OrderIsCompleted  OrderStatus = "completed"
+	OrderIsCancelled  OrderStatus = "cancelled"
+	OrderIsExpired    OrderStatus = "expired"
+	OrderIsOnHold     OrderStatus = "on-hold"
+	OrderIsCancelling OrderStatus = "cancelling"
+
+	OrderIsPartiallyRefunded OrderStatus = "partially-refunded"
+	OrderIsRefunded          OrderStatus = "refunded"
 )
 
 func (s OrderStatus) String() string {
 	switch s {
-	case OrderIsPending, OrderIsRejected, OrderIsApproved, OrderIsInProcess, OrderIsReady, OrderIsCompleted, OrderIsCancelled:
+	case OrderIsPending, OrderIsRejected, OrderIsApproved, OrderIsInProcess, OrderIsReady, OrderIsCompleted, OrderIsCancelled,
+		OrderIsExpired, OrderIsOnHold, OrderIsCancelling, OrderIsPartiallyRefunded, OrderIsRefunded:
 		return string(s)
 	default:
 		return ""
@@ -38,6 +45,16 @@ func ToOrderStatus(status string) OrderStatus {
 		return OrderIsCancelled
 	case OrderIsCompleted.String():
 		return OrderIsCompleted
+	case OrderIsExpired.String():
+		return OrderIsExpired
+	case OrderIsOnHold.String():
+		return OrderIsOnHold
+	case OrderIsCancelling.String():
+		return OrderIsCancelling
+	case OrderIsPartiallyRefunded.String():
+		return OrderIsPartiallyRefunded
+	case OrderIsRefunded.String():
+		return OrderIsRefunded
 	default:
 		return OrderUnknown
 	}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/stackus/errors"
+)
+
+// orderTransitions lists, for every status, the statuses an order may move to next.
+// Statuses without an entry are terminal.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderUnknown:     {OrderIsPending},
+	OrderIsPending:   {OrderIsApproved, OrderIsRejected, OrderIsCancelled, OrderIsExpired, OrderIsOnHold},
+	OrderIsApproved:  {OrderIsInProcess, OrderIsReady, OrderIsOnHold, OrderIsCancelling},
+	OrderIsInProcess: {OrderIsInProcess, OrderIsReady, OrderIsOnHold, OrderIsCancelling},
+	OrderIsReady:     {OrderIsCompleted, OrderIsOnHold},
+	OrderIsCompleted: {OrderIsPartiallyRefunded, OrderIsRefunded},
+	// held orders move on by the status they were held in, see heldTransitions;
+	// releasing a hold returns the order to the status it was held in
+	OrderIsOnHold: {OrderIsRejected, OrderIsCancelled, OrderIsCancelling},
+	// aborting a cancellation returns the order to the status it was cancelled from
+	OrderIsCancelling: {OrderIsCancelled},
+
+	OrderIsPartiallyRefunded: {OrderIsPartiallyRefunded, OrderIsRefunded},
+}
+
+// heldTransitions lists, for the status an order was held in, the statuses a held
+// order may move to. Pending orders keep their deadline while held and expire if
+// the hold is not released in time. Orders held after their approval already have
+// a shopping list and a confirmed payment, so they are only cancelled through the
+// cancel order saga.
+var heldTransitions = map[OrderStatus][]OrderStatus{
+	OrderIsPending:   {OrderIsRejected, OrderIsCancelled, OrderIsExpired},
+	OrderIsApproved:  {OrderIsCancelling},
+	OrderIsInProcess: {OrderIsCancelling},
+}
+
+type ErrInvalidTransition struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e ErrInvalidTransition) Error() string {
+	from := e.From.String()
+	if from == "" {
+		from = "unknown"
+	}
+	return fmt.Sprintf("the order cannot transition from %s to %s", from, e.To)
+}
+
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	for _, next := range orderTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether an order in the status can no longer change status.
+func (s OrderStatus) IsTerminal() bool {
+	_, exists := orderTransitions[s]
+	return !exists
+}
+
+func AllowedTransitions(order *Order) []OrderStatus {
+	next := order.nextStatuses()
+
+	allowed := make([]OrderStatus, len(next))
+	copy(allowed, next)
+
+	switch order.Status {
+	case OrderIsOnHold:
+		allowed = append(allowed, order.HeldStatus)
+	case OrderIsCancelling:
+		allowed = append(allowed, order.CancellingFrom)
+	}
+
+	return allowed
+}
+
+// nextStatuses is the order's entry in the transition table, narrowed by the held
+// status for orders on hold.
+func (o Order) nextStatuses() []OrderStatus {
+	if o.Status == OrderIsOnHold {
+		return heldTransitions[o.HeldStatus]
+	}
+	return orderTransitions[o.Status]
+}
+
+func (o Order) validateTransition(to OrderStatus) error {
+	for _, next := range o.nextStatuses() {
+		if next == to {
+			return nil
+		}
+	}
+	return errors.ErrFailedPrecondition.Err(ErrInvalidTransition{From: o.Status, To: to})
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stackus/errors"
+)
+
+func TestOrder_validateTransition(t *testing.T) {
+	tests := map[string]struct {
+		order   Order
+		to      OrderStatus
+		allowed bool
+	}{
+		"new order to pending":            {order: Order{}, to: OrderIsPending, allowed: true},
+		"new order to approved":           {order: Order{}, to: OrderIsApproved},
+		"pending to approved":             {order: Order{Status: OrderIsPending}, to: OrderIsApproved, allowed: true},
+		"pending to on hold":              {order: Order{Status: OrderIsPending}, to: OrderIsOnHold, allowed: true},
+		"pending to expired":              {order: Order{Status: OrderIsPending}, to: OrderIsExpired, allowed: true},
+		"pending to ready":                {order: Order{Status: OrderIsPending}, to: OrderIsReady},
+		"pending to pending":              {order: Order{Status: OrderIsPending}, to: OrderIsPending},
+		"approved to cancelling":          {order: Order{Status: OrderIsApproved}, to: OrderIsCancelling, allowed: true},
+		"approved to cancelled":           {order: Order{Status: OrderIsApproved}, to: OrderIsCancelled},
+		"in process to in process":        {order: Order{Status: OrderIsInProcess}, to: OrderIsInProcess, allowed: true},
+		"ready to completed":              {order: Order{Status: OrderIsReady}, to: OrderIsCompleted, allowed: true},
+		"ready to cancelling":             {order: Order{Status: OrderIsReady}, to: OrderIsCancelling},
+		"completed to refunded":           {order: Order{Status: OrderIsCompleted}, to: OrderIsRefunded, allowed: true},
+		"partially refunded to refunded":  {order: Order{Status: OrderIsPartiallyRefunded}, to: OrderIsRefunded, allowed: true},
+		"cancelling to cancelled":         {order: Order{Status: OrderIsCancelling}, to: OrderIsCancelled, allowed: true},
+		"cancelled to pending":            {order: Order{Status: OrderIsCancelled}, to: OrderIsPending},
+		"refunded to partially refunded":  {order: Order{Status: OrderIsRefunded}, to: OrderIsPartiallyRefunded},
+		"held pending order to rejected":  {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending}, to: OrderIsRejected, allowed: true},
+		"held pending order to cancelled": {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending}, to: OrderIsCancelled, allowed: true},
+		"held pending order to expired":   {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending}, to: OrderIsExpired, allowed: true},
+		"held pending order to cancelling": {
+			order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending},
+			to:    OrderIsCancelling,
+		},
+		"held approved order to cancelling": {
+			order:   Order{Status: OrderIsOnHold, HeldStatus: OrderIsApproved},
+			to:      OrderIsCancelling,
+			allowed: true,
+		},
+		"held approved order to cancelled": {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsApproved}, to: OrderIsCancelled},
+		"held approved order to rejected":  {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsApproved}, to: OrderIsRejected},
+		"held ready order to cancelling":   {order: Order{Status: OrderIsOnHold, HeldStatus: OrderIsReady}, to: OrderIsCancelling},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.order.validateTransition(tc.to)
+			if tc.allowed {
+				if err != nil {
+					t.Fatalf("transition from %q to %q refused: %v", tc.order.Status, tc.to, err)
+				}
+				return
+			}
+
+			if !errors.Is(err, errors.ErrFailedPrecondition) {
+				t.Errorf("err = %v, want %v", err, errors.ErrFailedPrecondition)
+			}
+			var invalid ErrInvalidTransition
+			if !errors.As(err, &invalid) {
+				t.Fatalf("err = %v, want an ErrInvalidTransition", err)
+			}
+			if invalid.From != tc.order.Status || invalid.To != tc.to {
+				t.Errorf("invalid transition = %+v, want from %q to %q", invalid, tc.order.Status, tc.to)
+			}
+		})
+	}
+}
+
+func TestAllowedTransitions(t *testing.T) {
+	tests := map[string]struct {
+		order *Order
+		want  []OrderStatus
+	}{
+		"pending": {
+			order: &Order{Status: OrderIsPending},
+			want:  []OrderStatus{OrderIsApproved, OrderIsRejected, OrderIsCancelled, OrderIsExpired, OrderIsOnHold},
+		},
+		"ready": {
+			order: &Order{Status: OrderIsReady},
+			want:  []OrderStatus{OrderIsCompleted, OrderIsOnHold},
+		},
+		"held pending order": {
+			order: &Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending},
+			want:  []OrderStatus{OrderIsRejected, OrderIsCancelled, OrderIsExpired, OrderIsPending},
+		},
+		"held approved order": {
+			order: &Order{Status: OrderIsOnHold, HeldStatus: OrderIsApproved},
+			want:  []OrderStatus{OrderIsCancelling, OrderIsApproved},
+		},
+		"held ready order": {
+			order: &Order{Status: OrderIsOnHold, HeldStatus: OrderIsReady},
+			want:  []OrderStatus{OrderIsReady},
+		},
+		"cancelling": {
+			order: &Order{Status: OrderIsCancelling, CancellingFrom: OrderIsInProcess},
+			want:  []OrderStatus{OrderIsCancelled, OrderIsInProcess},
+		},
+		"cancelled": {
+			order: &Order{Status: OrderIsCancelled},
+			want:  []OrderStatus{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := AllowedTransitions(tc.order); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("AllowedTransitions() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAllowedTransitionsDoesNotChangeTheTable(t *testing.T) {
+	held := &Order{Status: OrderIsOnHold, HeldStatus: OrderIsPending}
+	_ = AllowedTransitions(held)
+
+	want := []OrderStatus{OrderIsRejected, OrderIsCancelled, OrderIsExpired}
+	if got := heldTransitions[OrderIsPending]; !reflect.DeepEqual(got, want) {
+		t.Errorf("held transitions of pending orders = %v after AllowedTransitions, want %v", got, want)
+	}
+}
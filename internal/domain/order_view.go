@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrInvalidOrderViewCursor  = errors.Wrap(errors.ErrBadRequest, "the page cursor is not valid")
+	ErrSearchTextCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the search text cannot be blank")
+)
+
+// OrderView is the read model of an order. Version is the stream version of the
+// last event projected into it.
+type OrderView struct {
+	OrderID       string
+	CustomerID    string
+	PaymentID     string
+	Status        OrderStatus
+	Items         []Item
+	ItemCount     int
+	Subtotal      Money
+	DiscountTotal Money
+	TaxTotal      Money
+	Total         Money
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Version       int
+}
+
+// OrderViewRepository keeps the order read models. Save ignores views older than
+// the stored one, so the same events may be projected again. Find returns
+// ErrOrderNotFound for orders without a view.
+//
+// FindAll and Search return the newest orders first, starting after the cursor
+// when one is given.
+type OrderViewRepository interface {
+	Save(ctx context.Context, view OrderView) error
+	Find(ctx context.Context, orderID string) (*OrderView, error)
+	FindAll(ctx context.Context, filter OrderViewFilter, after *OrderViewCursor, limit int) ([]OrderView, error)
+	Search(ctx context.Context, text string, after *OrderViewCursor, limit int) ([]OrderView, error)
+}
+
+// OrderViewFilter narrows a listing of orders; zero fields do not filter. Orders
+// match when they hold an item of StoreID and were created in [CreatedFrom, CreatedTo).
+type OrderViewFilter struct {
+	CustomerID  string
+	Statuses    []OrderStatus
+	StoreID     string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// OrderViewCursor is the position of the last order of a page in the newest first
+// order of the listings; orders created at the same moment are ordered by ID.
+type OrderViewCursor struct {
+	CreatedAt time.Time `json:"c"`
+	OrderID   string    `json:"o"`
+}
+
+type OrderViewPage struct {
+	Orders []OrderView
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+func NewOrderViewCursor(view OrderView) OrderViewCursor {
+	return OrderViewCursor{
+		CreatedAt: view.CreatedAt,
+		OrderID:   view.OrderID,
+	}
+}
+
+// ParseOrderViewCursor reads a cursor handed out with an earlier page.
+func ParseOrderViewCursor(cursor string) (OrderViewCursor, error) {
+	var c OrderViewCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, ErrInvalidOrderViewCursor
+	}
+	if err = json.Unmarshal(data, &c); err != nil || c.OrderID == "" {
+		return c, ErrInvalidOrderViewCursor
+	}
+
+	return c, nil
+}
+
+// String encodes the cursor into an opaque token for the callers of the listings.
+func (c OrderViewCursor) String() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func NewOrderView(order *Order) OrderView {
+	var count int
+	for _, item := range order.Items {
+		count += item.Quantity
+	}
+
+	return OrderView{
+		OrderID:       order.ID(),
+		CustomerID:    order.CustomerID,
+		PaymentID:     order.PaymentID,
+		Status:        order.Status,
+		Items:         order.Items,
+		ItemCount:     count,
+		Subtotal:      order.GetSubtotal(),
+		DiscountTotal: order.GetDiscountTotal(),
+		TaxTotal:      order.GetTaxTotal(),
+		Total:         order.GetTotal(),
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.UpdatedAt,
+		Version:       order.Version(),
+	}
+}
@@ -2,8 +2,62 @@ package domain
 
 import (
 	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+var (
+	ErrPaymentNotConfirmed         = errors.Wrap(errors.ErrFailedPrecondition, "the payment could not be confirmed")
+	ErrPaymentAlreadyConfirmed     = errors.Wrap(errors.ErrFailedPrecondition, "the payment has already been confirmed")
+	ErrPaymentMustBeConfirmed      = errors.Wrap(errors.ErrFailedPrecondition, "the order cannot be approved before its payment is confirmed")
+	ErrPaymentCannotBeSettled      = errors.Wrap(errors.ErrFailedPrecondition, "the payment can only be confirmed or failed while the order is pending")
+	ErrPaymentFailureReasonIsBlank = errors.Wrap(errors.ErrBadRequest, "the payment failure reason cannot be blank")
 )
 
+// PaymentRepository checks payments with the payments service. Confirm returns
+// ErrPaymentNotConfirmed, possibly wrapped, when the payment was declined; Void
+// releases a confirmed payment again.
 type PaymentRepository interface {
 	Confirm(ctx context.Context, paymentID string) error
+	Void(ctx context.Context, paymentID string) error
+}
+
+func (o *Order) ConfirmPayment() (ddd.Event, error) {
+	if o.Status != OrderIsPending {
+		return nil, ErrPaymentCannotBeSettled
+	}
+
+	if o.PaymentConfirmed {
+		return nil, ErrPaymentAlreadyConfirmed
+	}
+
+	o.AddEvent(OrderPaymentConfirmedEvent, &OrderPaymentConfirmed{
+		PaymentID: o.PaymentID,
+	})
+
+	return ddd.NewEvent(OrderPaymentConfirmedEvent, o), nil
+}
+
+// FailPayment records a declined payment; the order is expected to be rejected next.
+func (o *Order) FailPayment(reason string) (ddd.Event, error) {
+	if reason == "" {
+		return nil, ErrPaymentFailureReasonIsBlank
+	}
+
+	if o.Status != OrderIsPending {
+		return nil, ErrPaymentCannotBeSettled
+	}
+
+	if o.PaymentConfirmed {
+		return nil, ErrPaymentAlreadyConfirmed
+	}
+
+	o.AddEvent(OrderPaymentFailedEvent, &OrderPaymentFailed{
+		PaymentID: o.PaymentID,
+		Reason:    reason,
+	})
+
+	return ddd.NewEvent(OrderPaymentFailedEvent, o), nil
 }
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+type (
+	// PromotionRule inspects the items and coupon codes of an order and reports the
+	// discount it grants, if any.
+	PromotionRule interface {
+		PromotionID() string
+		Evaluate(items []Item, couponCodes []string) (Discount, bool)
+	}
+
+	PromotionRepository interface {
+		FindActive(ctx context.Context) ([]PromotionRule, error)
+	}
+
+	Discount struct {
+		PromotionID string
+		Description string
+		Amount      Money
+	}
+
+	// PercentageOffStore discounts every item bought from one store.
+	PercentageOffStore struct {
+		ID          string
+		StoreID     string
+		BasisPoints int64
+	}
+
+	// BuyXGetY makes Get units of a product free for every Buy units paid for.
+	BuyXGetY struct {
+		ID        string
+		ProductID string
+		Buy       int
+		Get       int
+	}
+
+	// FixedCoupon takes a fixed amount off an order that presents the coupon code.
+	FixedCoupon struct {
+		ID     string
+		Code   string
+		Amount Money
+	}
+)
+
+var _ PromotionRule = (*PercentageOffStore)(nil)
+var _ PromotionRule = (*BuyXGetY)(nil)
+var _ PromotionRule = (*FixedCoupon)(nil)
+
+func (r PercentageOffStore) PromotionID() string { return r.ID }
+
+func (r PercentageOffStore) Evaluate(items []Item, _ []string) (Discount, bool) {
+	var storeTotal Money
+	var storeName string
+	for _, item := range items {
+		if item.StoreID == r.StoreID {
+			storeTotal = storeTotal.Add(item.Total())
+			storeName = item.StoreName
+		}
+	}
+
+	amount := storeTotal.Percent(r.BasisPoints)
+	if amount.Amount <= 0 {
+		return Discount{}, false
+	}
+
+	return Discount{
+		PromotionID: r.ID,
+		Description: fmt.Sprintf("%d.%02d%% off %s", r.BasisPoints/100, r.BasisPoints%100, storeName),
+		Amount:      amount,
+	}, true
+}
+
+func (r BuyXGetY) PromotionID() string { return r.ID }
+
+func (r BuyXGetY) Evaluate(items []Item, _ []string) (Discount, bool) {
+	if r.Buy <= 0 || r.Get <= 0 {
+		return Discount{}, false
+	}
+
+	for _, item := range items {
+		if item.ProductID != r.ProductID {
+			continue
+		}
+
+		free := item.Quantity / (r.Buy + r.Get) * r.Get
+		if free == 0 {
+			return Discount{}, false
+		}
+
+		return Discount{
+			PromotionID: r.ID,
+			Description: fmt.Sprintf("buy %d get %d free on %s", r.Buy, r.Get, item.ProductName),
+			Amount:      item.Price.Multiply(free),
+		}, true
+	}
+
+	return Discount{}, false
+}
+
+func (r FixedCoupon) PromotionID() string { return r.ID }
+
+func (r FixedCoupon) Evaluate(items []Item, couponCodes []string) (Discount, bool) {
+	if len(items) == 0 || items[0].Price.Currency != r.Amount.Currency {
+		return Discount{}, false
+	}
+
+	for _, code := range couponCodes {
+		if code == r.Code {
+			return Discount{
+				PromotionID: r.ID,
+				Description: fmt.Sprintf("coupon %s", r.Code),
+				Amount:      r.Amount,
+			}, true
+		}
+	}
+
+	return Discount{}, false
+}
+
+// EvaluatePromotions applies the rules in order and returns the discounts granted;
+// the combined discount never exceeds the subtotal of the items.
+func EvaluatePromotions(rules []PromotionRule, items []Item, couponCodes []string) []Discount {
+	var remaining Money
+	for _, item := range items {
+		remaining = remaining.Add(item.Total())
+	}
+
+	var discounts []Discount
+	for _, rule := range rules {
+		if remaining.Amount <= 0 {
+			break
+		}
+
+		discount, applies := rule.Evaluate(items, couponCodes)
+		if !applies || discount.Amount.Amount <= 0 {
+			continue
+		}
+
+		discount.Amount = discount.Amount.Min(remaining)
+		remaining = remaining.Sub(discount.Amount)
+		discounts = append(discounts, discount)
+	}
+
+	return discounts
+}
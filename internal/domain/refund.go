@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrRefundReasonCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the refund reason cannot be blank")
+	ErrRefundQuantityExceeded    = errors.Wrap(errors.ErrFailedPrecondition, "the refund quantity exceeds the quantity not yet refunded")
+	ErrRefundExceedsCharge       = errors.Wrap(errors.ErrFailedPrecondition, "the refund exceeds the amount charged")
+	ErrNothingToRefund           = errors.Wrap(errors.ErrFailedPrecondition, "the order has nothing left to refund")
+)
+
+type RefundLine struct {
+	ProductID string
+	Quantity  int
+	Amount    Money
+}
+
+// RefundableAmount is what was charged for the order less what has already been refunded.
+func (o Order) RefundableAmount() Money {
+	return o.GetTotal().Sub(o.RefundedTotal)
+}
+
+// refundLines prices each requested line at its share of the charged amount: the
+// goods, their tax, and a pro rata part of the order discounts.
+func (o Order) refundLines(lines []RefundLine) ([]RefundLine, Money, error) {
+	subtotal := o.GetSubtotal()
+	discount := o.GetDiscountTotal()
+
+	priced := make([]RefundLine, len(lines))
+	requested := make(map[string]int, len(lines))
+	var amount Money
+	for i, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, Money{}, ErrQuantityMustBePositive
+		}
+
+		item, exists := o.FindItem(line.ProductID)
+		if !exists {
+			return nil, Money{}, ErrOrderItemNotFound
+		}
+
+		requested[line.ProductID] += line.Quantity
+		if o.RefundedQuantities[line.ProductID]+requested[line.ProductID] > item.Quantity {
+			return nil, Money{}, ErrRefundQuantityExceeded
+		}
+
+		goods := item.Price.Multiply(line.Quantity)
+		var tax Money
+		if t, exists := o.findTax(line.ProductID); exists {
+			tax = o.Taxes[t].Amount.Prorate(int64(line.Quantity), int64(item.Quantity))
+		}
+
+		line.Amount = goods.Add(tax).Sub(discount.Prorate(goods.Amount, subtotal.Amount))
+		priced[i] = line
+		amount = amount.Add(line.Amount)
+	}
+
+	return priced, amount, nil
+}
+
+func (o Order) refundsEverything(lines []RefundLine) bool {
+	requested := make(map[string]int, len(lines))
+	for _, line := range lines {
+		requested[line.ProductID] += line.Quantity
+	}
+
+	for _, item := range o.Items {
+		if o.RefundedQuantities[item.ProductID]+requested[item.ProductID] < item.Quantity {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+type FulfillmentMethod string
+
+const (
+	FulfillmentMethodUnknown FulfillmentMethod = ""
+	PickupAtCounter          FulfillmentMethod = "pickup-counter"
+	PickupAtLocker           FulfillmentMethod = "locker"
+	BotDelivery              FulfillmentMethod = "bot-delivery"
+)
+
+var (
+	ErrFulfillmentMethodUnknown   = errors.Wrap(errors.ErrBadRequest, "the fulfillment method is not supported")
+	ErrFulfillmentLocationIsBlank = errors.Wrap(errors.ErrBadRequest, "the pickup location or delivery zone cannot be blank")
+	ErrTimeSlotIsInvalid          = errors.Wrap(errors.ErrBadRequest, "the time slot must end after it starts")
+	ErrTimeSlotIsFullyBooked      = errors.Wrap(errors.ErrResourceExhausted, "the time slot is fully booked")
+	ErrTimeSlotNotOffered         = errors.Wrap(errors.ErrNotFound, "the time slot is not offered at that location")
+	ErrOrderIsNotScheduled        = errors.Wrap(errors.ErrFailedPrecondition, "the order has no fulfillment to reschedule")
+	ErrOrderCannotBeRescheduled   = errors.Wrap(errors.ErrFailedPrecondition, "the order can no longer be rescheduled")
+	ErrTimeSlotIsAlreadyScheduled = errors.Wrap(errors.ErrBadRequest, "the order is already scheduled for that time slot")
+)
+
+type (
+	// Fulfillment is how and when the customer receives the order. LocationID is the
+	// pickup counter or locker bank, or the delivery zone for bot deliveries.
+	Fulfillment struct {
+		Method     FulfillmentMethod
+		LocationID string
+		Slot       TimeSlot
+	}
+
+	TimeSlot struct {
+		Start time.Time
+		End   time.Time
+	}
+
+	// SlotCapacity books orders into the time slots offered at each location and
+	// refuses bookings beyond a slot's capacity. Booking an order again moves it.
+	SlotCapacity interface {
+		Reserve(ctx context.Context, orderID string, fulfillment Fulfillment) error
+		Release(ctx context.Context, orderID string) error
+	}
+)
+
+func (m FulfillmentMethod) String() string {
+	switch m {
+	case PickupAtCounter, PickupAtLocker, BotDelivery:
+		return string(m)
+	default:
+		return ""
+	}
+}
+
+func (f Fulfillment) IsScheduled() bool {
+	return f.Method != FulfillmentMethodUnknown
+}
+
+func (f Fulfillment) validate() error {
+	if f.Method.String() == "" {
+		return ErrFulfillmentMethodUnknown
+	}
+
+	if f.LocationID == "" {
+		return ErrFulfillmentLocationIsBlank
+	}
+
+	return f.Slot.validate()
+}
+
+func (s TimeSlot) validate() error {
+	if !s.End.After(s.Start) {
+		return ErrTimeSlotIsInvalid
+	}
+	return nil
+}
+
+// Reschedule moves the order to another time slot at the same location; the order
+// must not yet be ready.
+func (o *Order) Reschedule(slot TimeSlot) (ddd.Event, error) {
+	if !o.Fulfillment.IsScheduled() {
+		return nil, ErrOrderIsNotScheduled
+	}
+
+	switch o.Status {
+	case OrderIsPending, OrderIsApproved, OrderIsInProcess:
+	default:
+		return nil, ErrOrderCannotBeRescheduled
+	}
+
+	if err := slot.validate(); err != nil {
+		return nil, err
+	}
+
+	if slot.Start.Equal(o.Fulfillment.Slot.Start) && slot.End.Equal(o.Fulfillment.Slot.End) {
+		return nil, ErrTimeSlotIsAlreadyScheduled
+	}
+
+	o.AddEvent(OrderRescheduledEvent, &OrderRescheduled{
+		CustomerID: o.CustomerID,
+		Previous:   o.Fulfillment.Slot,
+		Slot:       slot,
+	})
+
+	return ddd.NewEvent(OrderRescheduledEvent, o), nil
+}
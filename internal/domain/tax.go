@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// TaxCalculator determines the tax owed on each line from the rates in effect at
+	// the given moment. Lines are taxed on their undiscounted total.
+	TaxCalculator interface {
+		Calculate(ctx context.Context, items []Item, at time.Time) ([]LineTax, error)
+	}
+
+	// ProductCategoryRepository finds the tax category of products by their IDs;
+	// products it does not know are left out and taxed at the store wide rate.
+	ProductCategoryRepository interface {
+		Find(ctx context.Context, productIDs []string) (map[string]string, error)
+	}
+
+	LineTax struct {
+		ProductID   string
+		StoreID     string
+		Category    string
+		BasisPoints int64
+		Amount      Money
+	}
+)
+
+func (o Order) findTax(productID string) (int, bool) {
+	for i, tax := range o.Taxes {
+		if tax.ProductID == productID {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (o Order) copyTaxes() []LineTax {
+	taxes := make([]LineTax, len(o.Taxes))
+	copy(taxes, o.Taxes)
+	return taxes
+}
+
+// withTax replaces, or adds, the tax line for the product of the given tax.
+func (o Order) withTax(tax LineTax) []LineTax {
+	taxes := o.copyTaxes()
+	if i, exists := o.findTax(tax.ProductID); exists {
+		taxes[i] = tax
+		return taxes
+	}
+	return append(taxes, tax)
+}
+
+func (o Order) withoutTax(productID string) []LineTax {
+	taxes := o.copyTaxes()
+	if i, exists := o.findTax(productID); exists {
+		return append(taxes[:i], taxes[i+1:]...)
+	}
+	return taxes
+}
@@ -0,0 +1,140 @@
+package eventfile
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/registry"
+)
+
+// Exporter writes the event store as JSON Lines: the events in the order of their
+// streams, followed by the snapshots. Events are decoded with eventRegistry, the
+// registry the event store loads events with, and snapshots with registry.
+type Exporter struct {
+	db             *sql.DB
+	eventsTable    string
+	snapshotsTable string
+	registry       registry.Registry
+	eventRegistry  registry.Registry
+}
+
+func NewExporter(db *sql.DB, eventsTable, snapshotsTable string, registry, eventRegistry registry.Registry) Exporter {
+	return Exporter{
+		db:             db,
+		eventsTable:    eventsTable,
+		snapshotsTable: snapshotsTable,
+		registry:       registry,
+		eventRegistry:  eventRegistry,
+	}
+}
+
+// Export returns the number of events and snapshots written.
+func (e Exporter) Export(ctx context.Context, w io.Writer, filter Filter) (events, snapshots int, err error) {
+	const eventsQuery = `SELECT stream_id, stream_name, stream_version, event_id, event_name, event_data, occurred_at FROM %s %s
+ORDER BY stream_name, stream_id, stream_version`
+	const snapshotsQuery = `SELECT stream_id, stream_name, stream_version, snapshot_name, snapshot_data, taken_at FROM %s %s
+ORDER BY stream_name, stream_id`
+
+	encoder := json.NewEncoder(w)
+
+	where, args := filter.eventsWhere(e.eventsTable)
+	events, err = e.export(ctx, encoder, fmt.Sprintf(eventsQuery, e.eventsTable, where), args, EventRecord, e.eventRegistry)
+	if err != nil || !filter.Snapshots {
+		return events, 0, err
+	}
+
+	where, args = filter.where("taken_at")
+	snapshots, err = e.export(ctx, encoder, fmt.Sprintf(snapshotsQuery, e.snapshotsTable, where), args, SnapshotRecord, e.registry)
+
+	return events, snapshots, err
+}
+
+func (e Exporter) export(ctx context.Context, encoder *json.Encoder, query string, args []any, kind string, reg registry.Registry) (count int, err error) {
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "querying %ss", kind)
+	}
+	defer func(rows *sql.Rows) {
+		if cErr := rows.Close(); cErr != nil && err == nil {
+			err = errors.Wrapf(cErr, "closing %s rows", kind)
+		}
+	}(rows)
+
+	for rows.Next() {
+		record := Record{Kind: kind}
+		var data []byte
+		if kind == EventRecord {
+			err = rows.Scan(&record.StreamID, &record.StreamName, &record.StreamVersion, &record.EventID, &record.Name, &data, &record.Time)
+		} else {
+			err = rows.Scan(&record.StreamID, &record.StreamName, &record.StreamVersion, &record.Name, &data, &record.Time)
+		}
+		if err != nil {
+			return count, errors.Wrapf(err, "scanning %s", kind)
+		}
+
+		var v any
+		if v, err = reg.Deserialize(record.Name, data); err != nil {
+			return count, errors.Wrapf(err, "decoding %s %s v%d", kind, record.StreamID, record.StreamVersion)
+		}
+		if record.Payload, err = json.Marshal(v); err != nil {
+			return count, errors.Wrapf(err, "encoding %s %s v%d", kind, record.StreamID, record.StreamVersion)
+		}
+
+		if err = encoder.Encode(record); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, rows.Err()
+}
+
+// eventsWhere picks the events of every stream with an event in [From, To) from the
+// first version of the stream on, so that each stream in the file can be imported
+// into an empty store.
+func (f Filter) eventsWhere(eventsTable string) (string, []any) {
+	if f.From.IsZero() {
+		return f.where("occurred_at")
+	}
+
+	streams, args := f.where("occurred_at")
+	clause := fmt.Sprintf("WHERE (stream_name, stream_id) IN (SELECT stream_name, stream_id FROM %s %s)", eventsTable, streams)
+	if !f.To.IsZero() {
+		args = append(args, f.To)
+		clause += fmt.Sprintf(" AND occurred_at < $%d", len(args))
+	}
+
+	return clause, args
+}
+
+func (f Filter) where(timeColumn string) (string, []any) {
+	var clauses []string
+	var args []any
+
+	add := func(clause string, arg any) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
+
+	if f.StreamID != "" {
+		add("stream_id = $%d", f.StreamID)
+	}
+	if !f.From.IsZero() {
+		add(timeColumn+" >= $%d", f.From)
+	}
+	if !f.To.IsZero() {
+		add(timeColumn+" < $%d", f.To)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+
+	return "WHERE " + strings.Join(clauses, " AND "), args
+}
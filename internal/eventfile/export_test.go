@@ -0,0 +1,176 @@
+package eventfile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+	"github.com/v8tix/mallbots-ordering/internal/sqltest"
+)
+
+var (
+	from = time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	to   = time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC)
+)
+
+type noted struct {
+	Note string
+}
+
+func (noted) Key() string { return "test.Noted" }
+
+func testRegistry(t *testing.T) registry.Registry {
+	t.Helper()
+
+	reg := registry.New()
+	if err := serdes.NewJsonSerde(reg).Register(noted{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return reg
+}
+
+func TestFilter_where(t *testing.T) {
+	tests := map[string]struct {
+		filter    Filter
+		wantWhere string
+		wantArgs  []any
+	}{
+		"no filter": {
+			filter: Filter{},
+		},
+		"stream": {
+			filter:    Filter{StreamID: "order-1"},
+			wantWhere: "WHERE stream_id = $1",
+			wantArgs:  []any{"order-1"},
+		},
+		"from": {
+			filter:    Filter{From: from},
+			wantWhere: "WHERE taken_at >= $1",
+			wantArgs:  []any{from},
+		},
+		"to": {
+			filter:    Filter{To: to},
+			wantWhere: "WHERE taken_at < $1",
+			wantArgs:  []any{to},
+		},
+		"stream and range": {
+			filter:    Filter{StreamID: "order-1", From: from, To: to},
+			wantWhere: "WHERE stream_id = $1 AND taken_at >= $2 AND taken_at < $3",
+			wantArgs:  []any{"order-1", from, to},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			where, args := tc.filter.where("taken_at")
+			if where != tc.wantWhere {
+				t.Errorf("where = %q, want %q", where, tc.wantWhere)
+			}
+			if !reflect.DeepEqual(args, tc.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tc.wantArgs)
+			}
+		})
+	}
+}
+
+func TestFilter_eventsWhere(t *testing.T) {
+	tests := map[string]struct {
+		filter    Filter
+		wantWhere string
+		wantArgs  []any
+	}{
+		"no filter": {
+			filter: Filter{},
+		},
+		"to only": {
+			filter:    Filter{To: to},
+			wantWhere: "WHERE occurred_at < $1",
+			wantArgs:  []any{to},
+		},
+		"from": {
+			filter:    Filter{From: from},
+			wantWhere: "WHERE (stream_name, stream_id) IN (SELECT stream_name, stream_id FROM ordering.events WHERE occurred_at >= $1)",
+			wantArgs:  []any{from},
+		},
+		"stream and range": {
+			filter: Filter{StreamID: "order-1", From: from, To: to},
+			wantWhere: "WHERE (stream_name, stream_id) IN (SELECT stream_name, stream_id FROM ordering.events " +
+				"WHERE stream_id = $1 AND occurred_at >= $2 AND occurred_at < $3) AND occurred_at < $4",
+			wantArgs: []any{"order-1", from, to, to},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			where, args := tc.filter.eventsWhere("ordering.events")
+			if where != tc.wantWhere {
+				t.Errorf("where = %q, want %q", where, tc.wantWhere)
+			}
+			if !reflect.DeepEqual(args, tc.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tc.wantArgs)
+			}
+		})
+	}
+}
+
+func TestExporter_Export(t *testing.T) {
+	reg := testRegistry(t)
+
+	var queries []string
+	db := sqltest.Open(func(query string, args []driver.Value) (*sqltest.Rows, error) {
+		queries = append(queries, query)
+		switch {
+		case strings.Contains(query, "FROM ordering.events"):
+			return &sqltest.Rows{Values: [][]driver.Value{
+				{"order-1", "ordering.Order", int64(1), "event-1", "test.Noted", []byte(`{"Note":"first"}`), from.Add(-time.Hour)},
+				{"order-1", "ordering.Order", int64(2), "event-2", "test.Noted", []byte(`{"Note":"second"}`), from.Add(time.Hour)},
+			}}, nil
+		case strings.Contains(query, "FROM ordering.snapshots"):
+			return &sqltest.Rows{Values: [][]driver.Value{
+				{"order-1", "ordering.Order", int64(2), "test.Noted", []byte(`{"Note":"snapshot"}`), from.Add(time.Hour)},
+			}}, nil
+		}
+		return nil, errors.Wrapf(errors.ErrUnimplemented, "unexpected query %q", query)
+	})
+
+	var out bytes.Buffer
+	events, snapshots, err := NewExporter(db, "ordering.events", "ordering.snapshots", reg, reg).
+		Export(context.Background(), &out, Filter{From: from, Snapshots: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events != 2 || snapshots != 1 {
+		t.Errorf("exported %d events and %d snapshots, want 2 and 1", events, snapshots)
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "(stream_name, stream_id) IN") {
+		t.Errorf("queries = %q, want the events of the streams touched since From", queries)
+	}
+
+	var records []Record
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 3 {
+		t.Fatalf("wrote %d records, want 3", len(records))
+	}
+	if records[0].Kind != EventRecord || records[0].StreamVersion != 1 || string(records[0].Payload) != `{"Note":"first"}` {
+		t.Errorf("first record = %+v, want the first event of the stream", records[0])
+	}
+	if records[2].Kind != SnapshotRecord || records[2].StreamVersion != 2 {
+		t.Errorf("last record = %+v, want the snapshot", records[2])
+	}
+}
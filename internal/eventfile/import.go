@@ -0,0 +1,217 @@
+package eventfile
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/registry"
+)
+
+// maxRecordSize bounds the length of one line of an event file.
+const maxRecordSize = 16 * 1024 * 1024
+
+// Importer loads event files written by the Exporter into the event store, in a
+// single transaction. Payloads are stored in the current schema of the registries.
+//
+// Events must continue their stream: an event whose stream is not exactly one
+// version behind it is refused, unless the stream already holds that very event,
+// in which case it is skipped. Snapshots are only kept when they are ahead of the
+// stored snapshot and within the events of their stream.
+type Importer struct {
+	db             *sql.DB
+	eventsTable    string
+	snapshotsTable string
+	registry       registry.Registry
+	eventRegistry  registry.Registry
+}
+
+func NewImporter(db *sql.DB, eventsTable, snapshotsTable string, registry, eventRegistry registry.Registry) Importer {
+	return Importer{
+		db:             db,
+		eventsTable:    eventsTable,
+		snapshotsTable: snapshotsTable,
+		registry:       registry,
+		eventRegistry:  eventRegistry,
+	}
+}
+
+// ImportResult counts the records imported, and the events already in the store.
+type ImportResult struct {
+	Events    int
+	Skipped   int
+	Snapshots int
+}
+
+func (i Importer) Import(ctx context.Context, r io.Reader) (result ImportResult, err error) {
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	imp := streamImport{Importer: i, tx: tx, versions: make(map[string]int)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return result, errors.Wrapf(errors.ErrInvalidArgument, "line %d: %s", line, err)
+		}
+
+		switch record.Kind {
+		case EventRecord:
+			var imported bool
+			if imported, err = imp.event(ctx, record); err == nil && imported {
+				result.Events++
+			} else if err == nil {
+				result.Skipped++
+			}
+		case SnapshotRecord:
+			var imported bool
+			if imported, err = imp.snapshot(ctx, record); err == nil && imported {
+				result.Snapshots++
+			}
+		default:
+			err = errors.Wrapf(errors.ErrInvalidArgument, "unknown record kind %q", record.Kind)
+		}
+		if err != nil {
+			return result, errors.Wrapf(err, "line %d", line)
+		}
+	}
+
+	return result, scanner.Err()
+}
+
+type streamImport struct {
+	Importer
+	tx       *sql.Tx
+	versions map[string]int
+}
+
+func (i streamImport) event(ctx context.Context, record Record) (bool, error) {
+	const existingQuery = `SELECT event_id FROM %s WHERE stream_id = $1 AND stream_name = $2 AND stream_version = $3`
+	const insertQuery = `INSERT INTO %s (stream_id, stream_name, stream_version, event_id, event_name, event_data, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	version, err := i.streamVersion(ctx, record)
+	if err != nil {
+		return false, err
+	}
+
+	if record.StreamVersion <= version {
+		var eventID string
+		err = i.tx.QueryRowContext(ctx, fmt.Sprintf(existingQuery, i.eventsTable), record.StreamID, record.StreamName, record.StreamVersion).Scan(&eventID)
+		if err != nil {
+			return false, errors.Wrap(err, "reading stored event")
+		}
+		if eventID != record.EventID {
+			return false, errors.Wrapf(errors.ErrAlreadyExists, "stream %s already has another event at version %d", record.StreamID, record.StreamVersion)
+		}
+		return false, nil
+	}
+
+	if record.StreamVersion != version+1 {
+		return false, errors.Wrapf(errors.ErrFailedPrecondition, "stream %s is at version %d; version %d cannot follow it", record.StreamID, version, record.StreamVersion)
+	}
+
+	data, err := encode(i.eventRegistry, record)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = i.tx.ExecContext(ctx, fmt.Sprintf(insertQuery, i.eventsTable),
+		record.StreamID, record.StreamName, record.StreamVersion, record.EventID, record.Name, data, record.Time,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "inserting event")
+	}
+	i.versions[streamKey(record)] = record.StreamVersion
+
+	return true, nil
+}
+
+func (i streamImport) snapshot(ctx context.Context, record Record) (bool, error) {
+	const query = `INSERT INTO %s AS s (stream_id, stream_name, stream_version, snapshot_name, snapshot_data, taken_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (stream_id, stream_name) DO
+UPDATE SET stream_version = EXCLUDED.stream_version, snapshot_name = EXCLUDED.snapshot_name, snapshot_data = EXCLUDED.snapshot_data, taken_at = EXCLUDED.taken_at
+WHERE s.stream_version < EXCLUDED.stream_version`
+
+	version, err := i.streamVersion(ctx, record)
+	if err != nil {
+		return false, err
+	}
+	if record.StreamVersion > version {
+		return false, errors.Wrapf(errors.ErrFailedPrecondition, "the snapshot of stream %s at version %d is ahead of its events at version %d", record.StreamID, record.StreamVersion, version)
+	}
+
+	data, err := encode(i.registry, record)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := i.tx.ExecContext(ctx, fmt.Sprintf(query, i.snapshotsTable),
+		record.StreamID, record.StreamName, record.StreamVersion, record.Name, data, record.Time,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "upserting snapshot")
+	}
+	affected, err := result.RowsAffected()
+
+	return affected == 1, err
+}
+
+func (i streamImport) streamVersion(ctx context.Context, record Record) (int, error) {
+	const query = `SELECT COALESCE(MAX(stream_version), 0) FROM %s WHERE stream_id = $1 AND stream_name = $2`
+
+	key := streamKey(record)
+	if version, exists := i.versions[key]; exists {
+		return version, nil
+	}
+
+	var version int
+	err := i.tx.QueryRowContext(ctx, fmt.Sprintf(query, i.eventsTable), record.StreamID, record.StreamName).Scan(&version)
+	if err != nil {
+		return 0, errors.Wrap(err, "reading stream version")
+	}
+	i.versions[key] = version
+
+	return version, nil
+}
+
+// encode turns the decoded payload of a record back into its stored form.
+func encode(reg registry.Registry, record Record) ([]byte, error) {
+	v, err := reg.Build(record.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(record.Payload, v); err != nil {
+		return nil, errors.Wrapf(errors.ErrInvalidArgument, "decoding %s: %s", record.Name, err)
+	}
+
+	return reg.Serialize(record.Name, v)
+}
+
+func streamKey(record Record) string {
+	return record.StreamName + ":" + record.StreamID
+}
@@ -0,0 +1,203 @@
+package eventfile
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/sqltest"
+)
+
+// eventStore answers the statements of the importer the way the event store
+// tables would; inserts only show once the transaction is committed.
+type eventStore struct {
+	events    map[string]map[int]string
+	inserted  map[string]map[int]string
+	snapshots map[string]int
+	committed bool
+}
+
+func newEventStore() *eventStore {
+	return &eventStore{
+		events:    make(map[string]map[int]string),
+		inserted:  make(map[string]map[int]string),
+		snapshots: make(map[string]int),
+	}
+}
+
+func (s *eventStore) handle(query string, args []driver.Value) (*sqltest.Rows, error) {
+	switch {
+	case query == "BEGIN":
+		return nil, nil
+	case query == "COMMIT":
+		for stream, versions := range s.inserted {
+			for version, eventID := range versions {
+				s.store(s.events, stream, version, eventID)
+			}
+		}
+		s.committed = true
+		return nil, nil
+	case query == "ROLLBACK":
+		return nil, nil
+
+	case strings.Contains(query, "MAX(stream_version)"):
+		stream := args[1].(string) + ":" + args[0].(string)
+		version := 0
+		for v := range s.events[stream] {
+			if v > version {
+				version = v
+			}
+		}
+		return sqltest.Row(int64(version)), nil
+
+	case strings.HasPrefix(query, "SELECT event_id"):
+		stream := args[1].(string) + ":" + args[0].(string)
+		eventID, exists := s.events[stream][int(args[2].(int64))]
+		if !exists {
+			return &sqltest.Rows{}, nil
+		}
+		return sqltest.Row(eventID), nil
+
+	case strings.HasPrefix(query, "INSERT INTO ordering.events"):
+		s.store(s.inserted, args[1].(string)+":"+args[0].(string), int(args[2].(int64)), args[3].(string))
+		return sqltest.Affected(1), nil
+
+	case strings.HasPrefix(query, "INSERT INTO ordering.snapshots"):
+		stream := args[1].(string) + ":" + args[0].(string)
+		version := int(args[2].(int64))
+		if s.snapshots[stream] >= version {
+			return sqltest.Affected(0), nil
+		}
+		s.snapshots[stream] = version
+		return sqltest.Affected(1), nil
+	}
+
+	return nil, errors.Wrapf(errors.ErrUnimplemented, "unexpected query %q", query)
+}
+
+func (s *eventStore) store(events map[string]map[int]string, stream string, version int, eventID string) {
+	if events[stream] == nil {
+		events[stream] = make(map[int]string)
+	}
+	events[stream][version] = eventID
+}
+
+func eventLine(streamID string, version int, eventID string) string {
+	return fmt.Sprintf(`{"kind":"event","stream_id":%q,"stream_name":"ordering.Order","stream_version":%d,"event_id":%q,"name":"test.Noted","time":"2023-05-01T12:00:00Z","payload":{"Note":"v%d"}}`,
+		streamID, version, eventID, version,
+	)
+}
+
+func snapshotLine(streamID string, version int) string {
+	return fmt.Sprintf(`{"kind":"snapshot","stream_id":%q,"stream_name":"ordering.Order","stream_version":%d,"name":"test.Noted","time":"2023-05-01T12:00:00Z","payload":{"Note":"snapshot"}}`,
+		streamID, version,
+	)
+}
+
+func TestImporter_Import(t *testing.T) {
+	tests := map[string]struct {
+		stored     map[int]string
+		lines      []string
+		wantResult ImportResult
+		wantErr    error
+	}{
+		"new stream": {
+			lines:      []string{eventLine("order-1", 1, "e1"), eventLine("order-1", 2, "e2"), snapshotLine("order-1", 2)},
+			wantResult: ImportResult{Events: 2, Snapshots: 1},
+		},
+		"stream continued": {
+			stored:     map[int]string{1: "e1"},
+			lines:      []string{eventLine("order-1", 2, "e2")},
+			wantResult: ImportResult{Events: 1},
+		},
+		"events already stored": {
+			stored:     map[int]string{1: "e1", 2: "e2"},
+			lines:      []string{eventLine("order-1", 1, "e1"), eventLine("order-1", 2, "e2"), eventLine("order-1", 3, "e3")},
+			wantResult: ImportResult{Events: 1, Skipped: 2},
+		},
+		"stream not starting at the first version": {
+			lines:   []string{eventLine("order-1", 2, "e2")},
+			wantErr: errors.ErrFailedPrecondition,
+		},
+		"gap in the stream": {
+			lines:   []string{eventLine("order-1", 1, "e1"), eventLine("order-1", 3, "e3")},
+			wantErr: errors.ErrFailedPrecondition,
+		},
+		"another event at a stored version": {
+			stored:  map[int]string{1: "e1"},
+			lines:   []string{eventLine("order-1", 1, "other")},
+			wantErr: errors.ErrAlreadyExists,
+		},
+		"snapshot ahead of the events": {
+			lines:   []string{eventLine("order-1", 1, "e1"), snapshotLine("order-1", 2)},
+			wantErr: errors.ErrFailedPrecondition,
+		},
+		"unknown record kind": {
+			lines:   []string{`{"kind":"command","stream_id":"order-1"}`},
+			wantErr: errors.ErrInvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reg := testRegistry(t)
+			store := newEventStore()
+			for version, eventID := range tc.stored {
+				store.store(store.events, "ordering.Order:order-1", version, eventID)
+			}
+
+			importer := NewImporter(sqltest.Open(store.handle), "ordering.events", "ordering.snapshots", reg, reg)
+			result, err := importer.Import(context.Background(), strings.NewReader(strings.Join(tc.lines, "\n")+"\n"))
+
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tc.wantErr)
+				}
+				if store.committed {
+					t.Error("the import was committed after an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != tc.wantResult {
+				t.Errorf("result = %+v, want %+v", result, tc.wantResult)
+			}
+			if !store.committed {
+				t.Error("the import was not committed")
+			}
+		})
+	}
+}
+
+func TestImporter_ImportExportedRecord(t *testing.T) {
+	reg := testRegistry(t)
+	store := newEventStore()
+
+	record, err := json.Marshal(Record{
+		Kind:          EventRecord,
+		StreamID:      "order-1",
+		StreamName:    "ordering.Order",
+		StreamVersion: 1,
+		EventID:       "e1",
+		Name:          "test.Noted",
+		Time:          from,
+		Payload:       json.RawMessage(`{"Note":"first"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	importer := NewImporter(sqltest.Open(store.handle), "ordering.events", "ordering.snapshots", reg, reg)
+	if _, err = importer.Import(context.Background(), strings.NewReader(string(record))); err != nil {
+		t.Fatal(err)
+	}
+	if store.events["ordering.Order:order-1"][1] != "e1" {
+		t.Errorf("stored events = %v, want e1 at version 1", store.events)
+	}
+}
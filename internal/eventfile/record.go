@@ -0,0 +1,35 @@
+package eventfile
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	EventRecord    = "event"
+	SnapshotRecord = "snapshot"
+)
+
+// Record is one line of an event file. Payload holds the event or snapshot decoded
+// with the registry, in its current schema; Time is when the event occurred or the
+// snapshot was taken.
+type Record struct {
+	Kind          string          `json:"kind"`
+	StreamID      string          `json:"stream_id"`
+	StreamName    string          `json:"stream_name"`
+	StreamVersion int             `json:"stream_version"`
+	EventID       string          `json:"event_id,omitempty"`
+	Name          string          `json:"name"`
+	Time          time.Time       `json:"time"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Filter picks the streams and events to export; zero fields do not filter. Streams
+// are exported when one of their events occurred in [From, To), with all of their
+// events before To; snapshots are exported when they were taken in [From, To).
+type Filter struct {
+	StreamID  string
+	From      time.Time
+	To        time.Time
+	Snapshots bool
+}
@@ -0,0 +1,23 @@
+package fulfillmentpb
+
+import (
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+)
+
+// FulfillmentGroupReadiedEvent is published on the order aggregate channel of
+// mallbots-ordering-proto alongside the other order events.
+const FulfillmentGroupReadiedEvent = "ordersapi.FulfillmentGroupReadied"
+
+func Registrations(reg registry.Registry) (err error) {
+	serde := serdes.NewProtoSerde(reg)
+
+	// Order events
+	if err = serde.Register(&FulfillmentGroupReadied{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*FulfillmentGroupReadied) Key() string { return FulfillmentGroupReadiedEvent }
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: fulfillmentpb/fulfillment.api.proto
+
+package fulfillmentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReadyFulfillmentGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+}
+
+func (x *ReadyFulfillmentGroupRequest) Reset() {
+	*x = ReadyFulfillmentGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyFulfillmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyFulfillmentGroupRequest) ProtoMessage() {}
+
+func (x *ReadyFulfillmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyFulfillmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*ReadyFulfillmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadyFulfillmentGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadyFulfillmentGroupRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+type ReadyFulfillmentGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReadyFulfillmentGroupResponse) Reset() {
+	*x = ReadyFulfillmentGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyFulfillmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyFulfillmentGroupResponse) ProtoMessage() {}
+
+func (x *ReadyFulfillmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyFulfillmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*ReadyFulfillmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_api_proto_rawDescGZIP(), []int{1}
+}
+
+type HandOverFulfillmentGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+}
+
+func (x *HandOverFulfillmentGroupRequest) Reset() {
+	*x = HandOverFulfillmentGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandOverFulfillmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOverFulfillmentGroupRequest) ProtoMessage() {}
+
+func (x *HandOverFulfillmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOverFulfillmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*HandOverFulfillmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HandOverFulfillmentGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HandOverFulfillmentGroupRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+type HandOverFulfillmentGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HandOverFulfillmentGroupResponse) Reset() {
+	*x = HandOverFulfillmentGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandOverFulfillmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOverFulfillmentGroupResponse) ProtoMessage() {}
+
+func (x *HandOverFulfillmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOverFulfillmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*HandOverFulfillmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_api_proto_rawDescGZIP(), []int{3}
+}
+
+var File_fulfillmentpb_fulfillment_api_proto protoreflect.FileDescriptor
+
+var file_fulfillmentpb_fulfillment_api_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x49, 0x0a, 0x1c, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x1f, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65,
+	0x72, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x46,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe2, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x18, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x46,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x76,
+	0x65, 0x72, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3b, 0x5a, 0x39,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x38, 0x74, 0x69, 0x78,
+	0x2f, 0x6d, 0x61, 0x6c, 0x6c, 0x62, 0x6f, 0x74, 0x73, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_fulfillmentpb_fulfillment_api_proto_rawDescOnce sync.Once
+	file_fulfillmentpb_fulfillment_api_proto_rawDescData = file_fulfillmentpb_fulfillment_api_proto_rawDesc
+)
+
+func file_fulfillmentpb_fulfillment_api_proto_rawDescGZIP() []byte {
+	file_fulfillmentpb_fulfillment_api_proto_rawDescOnce.Do(func() {
+		file_fulfillmentpb_fulfillment_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_fulfillmentpb_fulfillment_api_proto_rawDescData)
+	})
+	return file_fulfillmentpb_fulfillment_api_proto_rawDescData
+}
+
+var file_fulfillmentpb_fulfillment_api_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_fulfillmentpb_fulfillment_api_proto_goTypes = []interface{}{
+	(*ReadyFulfillmentGroupRequest)(nil),     // 0: pb.ReadyFulfillmentGroupRequest
+	(*ReadyFulfillmentGroupResponse)(nil),    // 1: pb.ReadyFulfillmentGroupResponse
+	(*HandOverFulfillmentGroupRequest)(nil),  // 2: pb.HandOverFulfillmentGroupRequest
+	(*HandOverFulfillmentGroupResponse)(nil), // 3: pb.HandOverFulfillmentGroupResponse
+}
+var file_fulfillmentpb_fulfillment_api_proto_depIdxs = []int32{
+	0, // 0: pb.OrderFulfillmentService.ReadyFulfillmentGroup:input_type -> pb.ReadyFulfillmentGroupRequest
+	2, // 1: pb.OrderFulfillmentService.HandOverFulfillmentGroup:input_type -> pb.HandOverFulfillmentGroupRequest
+	1, // 2: pb.OrderFulfillmentService.ReadyFulfillmentGroup:output_type -> pb.ReadyFulfillmentGroupResponse
+	3, // 3: pb.OrderFulfillmentService.HandOverFulfillmentGroup:output_type -> pb.HandOverFulfillmentGroupResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_fulfillmentpb_fulfillment_api_proto_init() }
+func file_fulfillmentpb_fulfillment_api_proto_init() {
+	if File_fulfillmentpb_fulfillment_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fulfillmentpb_fulfillment_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyFulfillmentGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fulfillmentpb_fulfillment_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyFulfillmentGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fulfillmentpb_fulfillment_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandOverFulfillmentGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fulfillmentpb_fulfillment_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandOverFulfillmentGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fulfillmentpb_fulfillment_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillmentpb_fulfillment_api_proto_goTypes,
+		DependencyIndexes: file_fulfillmentpb_fulfillment_api_proto_depIdxs,
+		MessageInfos:      file_fulfillmentpb_fulfillment_api_proto_msgTypes,
+	}.Build()
+	File_fulfillmentpb_fulfillment_api_proto = out.File
+	file_fulfillmentpb_fulfillment_api_proto_rawDesc = nil
+	file_fulfillmentpb_fulfillment_api_proto_goTypes = nil
+	file_fulfillmentpb_fulfillment_api_proto_depIdxs = nil
+}
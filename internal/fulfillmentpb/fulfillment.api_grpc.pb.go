@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: fulfillmentpb/fulfillment.api.proto
+
+package fulfillmentpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OrderFulfillmentService_ReadyFulfillmentGroup_FullMethodName    = "/pb.OrderFulfillmentService/ReadyFulfillmentGroup"
+	OrderFulfillmentService_HandOverFulfillmentGroup_FullMethodName = "/pb.OrderFulfillmentService/HandOverFulfillmentGroup"
+)
+
+// OrderFulfillmentServiceClient is the client API for OrderFulfillmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderFulfillmentServiceClient interface {
+	ReadyFulfillmentGroup(ctx context.Context, in *ReadyFulfillmentGroupRequest, opts ...grpc.CallOption) (*ReadyFulfillmentGroupResponse, error)
+	HandOverFulfillmentGroup(ctx context.Context, in *HandOverFulfillmentGroupRequest, opts ...grpc.CallOption) (*HandOverFulfillmentGroupResponse, error)
+}
+
+type orderFulfillmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderFulfillmentServiceClient(cc grpc.ClientConnInterface) OrderFulfillmentServiceClient {
+	return &orderFulfillmentServiceClient{cc}
+}
+
+func (c *orderFulfillmentServiceClient) ReadyFulfillmentGroup(ctx context.Context, in *ReadyFulfillmentGroupRequest, opts ...grpc.CallOption) (*ReadyFulfillmentGroupResponse, error) {
+	out := new(ReadyFulfillmentGroupResponse)
+	err := c.cc.Invoke(ctx, OrderFulfillmentService_ReadyFulfillmentGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderFulfillmentServiceClient) HandOverFulfillmentGroup(ctx context.Context, in *HandOverFulfillmentGroupRequest, opts ...grpc.CallOption) (*HandOverFulfillmentGroupResponse, error) {
+	out := new(HandOverFulfillmentGroupResponse)
+	err := c.cc.Invoke(ctx, OrderFulfillmentService_HandOverFulfillmentGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderFulfillmentServiceServer is the server API for OrderFulfillmentService service.
+// All implementations must embed UnimplementedOrderFulfillmentServiceServer
+// for forward compatibility
+type OrderFulfillmentServiceServer interface {
+	ReadyFulfillmentGroup(context.Context, *ReadyFulfillmentGroupRequest) (*ReadyFulfillmentGroupResponse, error)
+	HandOverFulfillmentGroup(context.Context, *HandOverFulfillmentGroupRequest) (*HandOverFulfillmentGroupResponse, error)
+	mustEmbedUnimplementedOrderFulfillmentServiceServer()
+}
+
+// UnimplementedOrderFulfillmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrderFulfillmentServiceServer struct {
+}
+
+func (UnimplementedOrderFulfillmentServiceServer) ReadyFulfillmentGroup(context.Context, *ReadyFulfillmentGroupRequest) (*ReadyFulfillmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadyFulfillmentGroup not implemented")
+}
+func (UnimplementedOrderFulfillmentServiceServer) HandOverFulfillmentGroup(context.Context, *HandOverFulfillmentGroupRequest) (*HandOverFulfillmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOverFulfillmentGroup not implemented")
+}
+func (UnimplementedOrderFulfillmentServiceServer) mustEmbedUnimplementedOrderFulfillmentServiceServer() {
+}
+
+// UnsafeOrderFulfillmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderFulfillmentServiceServer will
+// result in compilation errors.
+type UnsafeOrderFulfillmentServiceServer interface {
+	mustEmbedUnimplementedOrderFulfillmentServiceServer()
+}
+
+func RegisterOrderFulfillmentServiceServer(s grpc.ServiceRegistrar, srv OrderFulfillmentServiceServer) {
+	s.RegisterService(&OrderFulfillmentService_ServiceDesc, srv)
+}
+
+func _OrderFulfillmentService_ReadyFulfillmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyFulfillmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderFulfillmentServiceServer).ReadyFulfillmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderFulfillmentService_ReadyFulfillmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderFulfillmentServiceServer).ReadyFulfillmentGroup(ctx, req.(*ReadyFulfillmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderFulfillmentService_HandOverFulfillmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOverFulfillmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderFulfillmentServiceServer).HandOverFulfillmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderFulfillmentService_HandOverFulfillmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderFulfillmentServiceServer).HandOverFulfillmentGroup(ctx, req.(*HandOverFulfillmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderFulfillmentService_ServiceDesc is the grpc.ServiceDesc for OrderFulfillmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderFulfillmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.OrderFulfillmentService",
+	HandlerType: (*OrderFulfillmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadyFulfillmentGroup",
+			Handler:    _OrderFulfillmentService_ReadyFulfillmentGroup_Handler,
+		},
+		{
+			MethodName: "HandOverFulfillmentGroup",
+			Handler:    _OrderFulfillmentService_HandOverFulfillmentGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fulfillmentpb/fulfillment.api.proto",
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: fulfillmentpb/fulfillment.events.proto
+
+package fulfillmentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FulfillmentGroupReadied announces that the items of one store are picked and
+// waiting to be handed over. OrderReadied follows once every store is ready.
+type FulfillmentGroupReadied struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId    string                          `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	CustomerId string                          `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PaymentId  string                          `protobuf:"bytes,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Items      []*FulfillmentGroupReadied_Item `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *FulfillmentGroupReadied) Reset() {
+	*x = FulfillmentGroupReadied{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FulfillmentGroupReadied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentGroupReadied) ProtoMessage() {}
+
+func (x *FulfillmentGroupReadied) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentGroupReadied.ProtoReflect.Descriptor instead.
+func (*FulfillmentGroupReadied) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FulfillmentGroupReadied) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FulfillmentGroupReadied) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *FulfillmentGroupReadied) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *FulfillmentGroupReadied) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *FulfillmentGroupReadied) GetItems() []*FulfillmentGroupReadied_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FulfillmentGroupReadied_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *FulfillmentGroupReadied_Item) Reset() {
+	*x = FulfillmentGroupReadied_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fulfillmentpb_fulfillment_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FulfillmentGroupReadied_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentGroupReadied_Item) ProtoMessage() {}
+
+func (x *FulfillmentGroupReadied_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillmentpb_fulfillment_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentGroupReadied_Item.ProtoReflect.Descriptor instead.
+func (*FulfillmentGroupReadied_Item) Descriptor() ([]byte, []int) {
+	return file_fulfillmentpb_fulfillment_events_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *FulfillmentGroupReadied_Item) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FulfillmentGroupReadied_Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+var File_fulfillmentpb_fulfillment_events_proto protoreflect.FileDescriptor
+
+var file_fulfillmentpb_fulfillment_events_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xff, 0x01, 0x0a,
+	0x17, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x61, 0x64, 0x69, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x69, 0x65, 0x64, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x41, 0x0a, 0x04, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x3b,
+	0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x38, 0x74,
+	0x69, 0x78, 0x2f, 0x6d, 0x61, 0x6c, 0x6c, 0x62, 0x6f, 0x74, 0x73, 0x2d, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fulfillmentpb_fulfillment_events_proto_rawDescOnce sync.Once
+	file_fulfillmentpb_fulfillment_events_proto_rawDescData = file_fulfillmentpb_fulfillment_events_proto_rawDesc
+)
+
+func file_fulfillmentpb_fulfillment_events_proto_rawDescGZIP() []byte {
+	file_fulfillmentpb_fulfillment_events_proto_rawDescOnce.Do(func() {
+		file_fulfillmentpb_fulfillment_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_fulfillmentpb_fulfillment_events_proto_rawDescData)
+	})
+	return file_fulfillmentpb_fulfillment_events_proto_rawDescData
+}
+
+var file_fulfillmentpb_fulfillment_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fulfillmentpb_fulfillment_events_proto_goTypes = []interface{}{
+	(*FulfillmentGroupReadied)(nil),      // 0: pb.FulfillmentGroupReadied
+	(*FulfillmentGroupReadied_Item)(nil), // 1: pb.FulfillmentGroupReadied.Item
+}
+var file_fulfillmentpb_fulfillment_events_proto_depIdxs = []int32{
+	1, // 0: pb.FulfillmentGroupReadied.items:type_name -> pb.FulfillmentGroupReadied.Item
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fulfillmentpb_fulfillment_events_proto_init() }
+func file_fulfillmentpb_fulfillment_events_proto_init() {
+	if File_fulfillmentpb_fulfillment_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fulfillmentpb_fulfillment_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FulfillmentGroupReadied); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fulfillmentpb_fulfillment_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FulfillmentGroupReadied_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fulfillmentpb_fulfillment_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fulfillmentpb_fulfillment_events_proto_goTypes,
+		DependencyIndexes: file_fulfillmentpb_fulfillment_events_proto_depIdxs,
+		MessageInfos:      file_fulfillmentpb_fulfillment_events_proto_msgTypes,
+	}.Build()
+	File_fulfillmentpb_fulfillment_events_proto = out.File
+	file_fulfillmentpb_fulfillment_events_proto_rawDesc = nil
+	file_fulfillmentpb_fulfillment_events_proto_goTypes = nil
+	file_fulfillmentpb_fulfillment_events_proto_depIdxs = nil
+}
@@ -0,0 +1,6 @@
+// Package fulfillmentpb holds the API and integration events for the per store
+// fulfillment groups of an order. They extend the ordering API of
+// mallbots-ordering-proto until that module carries them.
+package fulfillmentpb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=fulfillmentpb/api.annotations.yaml fulfillmentpb/fulfillment.api.proto fulfillmentpb/fulfillment.events.proto
@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/adminpb"
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
+)
+
+// RegisterGateway mounts the REST routes of the ordering API, of the order details,
+// of the order amendments, of the scheduled orders, of the refunds, of the returns,
+// of the fulfillment groups and of the admin API under a single root.
+func RegisterGateway(ctx context.Context, mux *chi.Mux, grpcAddr string) error {
+	const apiRoot = "/api/ordering"
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	gateway := runtime.NewServeMux()
+	if err := pb.RegisterOrderingServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := detailspb.RegisterOrderDetailsServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := amendmentspb.RegisterOrderAmendmentServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := schedulingpb.RegisterOrderSchedulingServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := refundspb.RegisterOrderRefundServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := returnspb.RegisterOrderReturnServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := fulfillmentpb.RegisterOrderFulfillmentServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+	if err := adminpb.RegisterOrderAdminServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, opts); err != nil {
+		return err
+	}
+
+	// mount the GRPC gateway
+	mux.Mount(apiRoot, gateway)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"net/http"
+)
+
+// The REST gateway only passes on headers prefixed with Grpc-Metadata- as gRPC
+// metadata; forwardedHeaders lists the plain headers clients may send instead.
+var forwardedHeaders = []string{
+	"Idempotency-Key",
+	"Expected-Version",
+	"Coupon-Codes",
+}
+
+// ForwardHeaders copies the forwarded headers of a request to their Grpc-Metadata-
+// form unless the client already sent that.
+func ForwardHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, header := range forwardedHeaders {
+			value := r.Header.Get(header)
+			if value == "" || r.Header.Get("Grpc-Metadata-"+header) != "" {
+				continue
+			}
+			r.Header.Set("Grpc-Metadata-"+header, value)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
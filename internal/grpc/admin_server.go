@@ -0,0 +1,224 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/v8tix/mallbots-ordering/internal/adminpb"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/application/queries"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type adminServer struct {
+	app application.App
+	adminpb.UnimplementedOrderAdminServiceServer
+}
+
+var _ adminpb.OrderAdminServiceServer = (*adminServer)(nil)
+
+func RegisterAdminServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	adminpb.RegisterOrderAdminServiceServer(registrar, adminServer{app: app})
+	return nil
+}
+
+func (s adminServer) ListOrders(ctx context.Context, request *adminpb.ListOrdersRequest) (*adminpb.ListOrdersResponse, error) {
+	statuses := make([]domain.OrderStatus, len(request.GetStatuses()))
+	for i, status := range request.GetStatuses() {
+		statuses[i] = domain.ToOrderStatus(status)
+	}
+
+	query := queries.ListOrders{
+		CustomerID: request.GetCustomerId(),
+		Statuses:   statuses,
+		StoreID:    request.GetStoreId(),
+		Limit:      int(request.GetLimit()),
+		Cursor:     request.GetCursor(),
+	}
+	if request.GetCreatedFrom() != nil {
+		query.CreatedFrom = request.GetCreatedFrom().AsTime()
+	}
+	if request.GetCreatedTo() != nil {
+		query.CreatedTo = request.GetCreatedTo().AsTime()
+	}
+
+	page, err := s.app.ListOrders(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.ListOrdersResponse{
+		Orders:     s.ordersFromDomain(page.Orders),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s adminServer) SearchOrders(ctx context.Context, request *adminpb.SearchOrdersRequest) (*adminpb.SearchOrdersResponse, error) {
+	page, err := s.app.SearchOrders(ctx, queries.SearchOrders{
+		Text:   request.GetText(),
+		Limit:  int(request.GetLimit()),
+		Cursor: request.GetCursor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.SearchOrdersResponse{
+		Orders:     s.ordersFromDomain(page.Orders),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s adminServer) GetOrderHistory(ctx context.Context, request *adminpb.GetOrderHistoryRequest) (*adminpb.GetOrderHistoryResponse, error) {
+	events, err := s.app.GetOrderHistory(ctx, queries.GetOrderHistory{
+		ID:              request.GetId(),
+		IncludePayloads: request.GetIncludePayloads(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*adminpb.OrderHistoryEvent, len(events))
+	for i, event := range events {
+		history[i] = &adminpb.OrderHistoryEvent{
+			Id:         event.ID,
+			Name:       event.Name,
+			Version:    int32(event.Version),
+			OccurredAt: timestamppb.New(event.OccurredAt),
+			Summary:    event.Summary(),
+			Payload:    string(event.Data),
+		}
+	}
+
+	return &adminpb.GetOrderHistoryResponse{Events: history}, nil
+}
+
+func (s adminServer) GetOrderAsOf(ctx context.Context, request *adminpb.GetOrderAsOfRequest) (*adminpb.GetOrderAsOfResponse, error) {
+	query := queries.GetOrderAsOf{
+		ID:      request.GetId(),
+		Version: int(request.GetVersion()),
+	}
+	if request.GetAsOf() != nil {
+		query.Time = request.GetAsOf().AsTime()
+	}
+
+	order, err := s.app.GetOrderAsOf(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.GetOrderAsOfResponse{
+		Order: s.orderFromDomain(domain.NewOrderView(order)),
+	}, nil
+}
+
+func (s adminServer) GetOrderSaga(ctx context.Context, request *adminpb.GetOrderSagaRequest) (*adminpb.GetOrderSagaResponse, error) {
+	saga, err := s.app.GetOrderSaga(ctx, queries.GetOrderSaga{ID: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminpb.GetOrderSagaResponse{
+		Saga: &adminpb.OrderSaga{
+			OrderId:       saga.OrderID,
+			Step:          saga.Step,
+			Done:          saga.Done,
+			Compensating:  saga.Compensating,
+			RefusalReason: saga.RefusalReason,
+		},
+	}, nil
+}
+
+func (s adminServer) PlaceOnHold(ctx context.Context, request *adminpb.PlaceOnHoldRequest) (*adminpb.PlaceOnHoldResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.PlaceOnHold(ctx, commands.PlaceOnHold{
+		ID:              request.GetId(),
+		Reason:          request.GetReason(),
+		ExpectedVersion: version,
+	})
+
+	return &adminpb.PlaceOnHoldResponse{}, err
+}
+
+func (s adminServer) ReleaseHold(ctx context.Context, request *adminpb.ReleaseHoldRequest) (*adminpb.ReleaseHoldResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ReleaseHold(ctx, commands.ReleaseHold{
+		ID:              request.GetId(),
+		Note:            request.GetNote(),
+		ExpectedVersion: version,
+	})
+
+	return &adminpb.ReleaseHoldResponse{}, err
+}
+
+func (s adminServer) ListHeldOrders(ctx context.Context, request *adminpb.ListHeldOrdersRequest) (*adminpb.ListHeldOrdersResponse, error) {
+	held, err := s.app.ListHeldOrders(ctx, queries.ListHeldOrders{Limit: int(request.GetLimit())})
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*adminpb.HeldOrder, len(held))
+	for i, order := range held {
+		orders[i] = &adminpb.HeldOrder{
+			OrderId:    order.OrderID,
+			CustomerId: order.CustomerID,
+			Reason:     order.Reason,
+			RiskScore:  int32(order.RiskScore),
+			Currency:   order.Total.Currency,
+			Total:      order.Total.Amount,
+			HeldAt:     timestamppb.New(order.HeldAt),
+		}
+	}
+
+	return &adminpb.ListHeldOrdersResponse{Orders: orders}, nil
+}
+
+func (s adminServer) ordersFromDomain(views []domain.OrderView) []*adminpb.OrderSummary {
+	orders := make([]*adminpb.OrderSummary, len(views))
+	for i, view := range views {
+		orders[i] = s.orderFromDomain(view)
+	}
+	return orders
+}
+
+func (s adminServer) orderFromDomain(view domain.OrderView) *adminpb.OrderSummary {
+	items := make([]*adminpb.OrderSummary_Item, len(view.Items))
+	for i, item := range view.Items {
+		items[i] = &adminpb.OrderSummary_Item{
+			StoreId:     item.StoreID,
+			ProductId:   item.ProductID,
+			StoreName:   item.StoreName,
+			ProductName: item.ProductName,
+			Price:       item.Price.Amount,
+			Quantity:    int32(item.Quantity),
+		}
+	}
+
+	return &adminpb.OrderSummary{
+		Id:            view.OrderID,
+		CustomerId:    view.CustomerID,
+		PaymentId:     view.PaymentID,
+		Status:        view.Status.String(),
+		Items:         items,
+		ItemCount:     int32(view.ItemCount),
+		Currency:      view.Total.Currency,
+		Subtotal:      view.Subtotal.Amount,
+		DiscountTotal: view.DiscountTotal.Amount,
+		TaxTotal:      view.TaxTotal.Amount,
+		Total:         view.Total.Amount,
+		CreatedAt:     timestamppb.New(view.CreatedAt),
+		UpdatedAt:     timestamppb.New(view.UpdatedAt),
+		Version:       int32(view.Version),
+	}
+}
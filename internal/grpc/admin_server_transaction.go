@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/adminpb"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+)
+
+type adminServerTx struct {
+	c di.Container
+	adminpb.UnimplementedOrderAdminServiceServer
+}
+
+var _ adminpb.OrderAdminServiceServer = (*adminServerTx)(nil)
+
+func (s adminServerTx) ListOrders(ctx context.Context, request *adminpb.ListOrdersRequest) (resp *adminpb.ListOrdersResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ListOrders(ctx, request)
+}
+
+func (s adminServerTx) SearchOrders(ctx context.Context, request *adminpb.SearchOrdersRequest) (resp *adminpb.SearchOrdersResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.SearchOrders(ctx, request)
+}
+
+func (s adminServerTx) GetOrderHistory(ctx context.Context, request *adminpb.GetOrderHistoryRequest) (resp *adminpb.GetOrderHistoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.GetOrderHistory(ctx, request)
+}
+
+func (s adminServerTx) GetOrderAsOf(ctx context.Context, request *adminpb.GetOrderAsOfRequest) (resp *adminpb.GetOrderAsOfResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.GetOrderAsOf(ctx, request)
+}
+
+func (s adminServerTx) GetOrderSaga(ctx context.Context, request *adminpb.GetOrderSagaRequest) (resp *adminpb.GetOrderSagaResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.GetOrderSaga(ctx, request)
+}
+
+func (s adminServerTx) PlaceOnHold(ctx context.Context, request *adminpb.PlaceOnHoldRequest) (resp *adminpb.PlaceOnHoldResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.PlaceOnHold(ctx, request)
+}
+
+func (s adminServerTx) ReleaseHold(ctx context.Context, request *adminpb.ReleaseHoldRequest) (resp *adminpb.ReleaseHoldResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ReleaseHold(ctx, request)
+}
+
+func (s adminServerTx) ListHeldOrders(ctx context.Context, request *adminpb.ListHeldOrdersRequest) (resp *adminpb.ListHeldOrdersResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := adminServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ListHeldOrders(ctx, request)
+}
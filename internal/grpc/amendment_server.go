@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type amendmentServer struct {
+	app application.App
+	amendmentspb.UnimplementedOrderAmendmentServiceServer
+}
+
+var _ amendmentspb.OrderAmendmentServiceServer = (*amendmentServer)(nil)
+
+func RegisterAmendmentServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	amendmentspb.RegisterOrderAmendmentServiceServer(registrar, amendmentServer{app: app})
+	return nil
+}
+
+func (s amendmentServer) AddOrderItem(ctx context.Context, request *amendmentspb.AddOrderItemRequest) (*amendmentspb.AddOrderItemResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.AddOrderItem(ctx, commands.AddOrderItem{
+		ID: request.GetId(),
+		Item: domain.Item{
+			ProductID:   request.GetProductId(),
+			StoreID:     request.GetStoreId(),
+			StoreName:   request.GetStoreName(),
+			ProductName: request.GetProductName(),
+			Price:       domain.MoneyFromFloat(request.GetPrice(), domain.DefaultCurrency),
+			Quantity:    int(request.GetQuantity()),
+		},
+		ExpectedVersion: version,
+	})
+
+	return &amendmentspb.AddOrderItemResponse{}, err
+}
+
+func (s amendmentServer) RemoveOrderItem(ctx context.Context, request *amendmentspb.RemoveOrderItemRequest) (*amendmentspb.RemoveOrderItemResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.RemoveOrderItem(ctx, commands.RemoveOrderItem{
+		ID:              request.GetId(),
+		ProductID:       request.GetProductId(),
+		ExpectedVersion: version,
+	})
+
+	return &amendmentspb.RemoveOrderItemResponse{}, err
+}
+
+func (s amendmentServer) ChangeItemQuantity(ctx context.Context, request *amendmentspb.ChangeItemQuantityRequest) (*amendmentspb.ChangeItemQuantityResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ChangeItemQuantity(ctx, commands.ChangeItemQuantity{
+		ID:              request.GetId(),
+		ProductID:       request.GetProductId(),
+		Quantity:        int(request.GetQuantity()),
+		ExpectedVersion: version,
+	})
+
+	return &amendmentspb.ChangeItemQuantityResponse{}, err
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+)
+
+type amendmentServerTx struct {
+	c di.Container
+	amendmentspb.UnimplementedOrderAmendmentServiceServer
+}
+
+var _ amendmentspb.OrderAmendmentServiceServer = (*amendmentServerTx)(nil)
+
+func (s amendmentServerTx) AddOrderItem(ctx context.Context, request *amendmentspb.AddOrderItemRequest) (resp *amendmentspb.AddOrderItemResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := amendmentServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.AddOrderItem(ctx, request)
+}
+
+func (s amendmentServerTx) RemoveOrderItem(ctx context.Context, request *amendmentspb.RemoveOrderItemRequest) (resp *amendmentspb.RemoveOrderItemResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := amendmentServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.RemoveOrderItem(ctx, request)
+}
+
+func (s amendmentServerTx) ChangeItemQuantity(ctx context.Context, request *amendmentspb.ChangeItemQuantityRequest) (resp *amendmentspb.ChangeItemQuantityResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := amendmentServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ChangeItemQuantity(ctx, request)
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/v8tix/mallbots-ordering/internal/customerspb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type CustomerRepository struct {
+	client  customerspb.CustomersServiceClient
+	timeout time.Duration
+}
+
+var _ domain.CustomerRepository = (*CustomerRepository)(nil)
+
+func NewCustomerRepository(conn *grpc.ClientConn, timeout time.Duration) CustomerRepository {
+	return CustomerRepository{
+		client:  customerspb.NewCustomersServiceClient(conn),
+		timeout: timeout,
+	}
+}
+
+func (r CustomerRepository) Authorize(ctx context.Context, customerID string) error {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	_, err := r.client.AuthorizeCustomer(ctx, &customerspb.AuthorizeCustomerRequest{Id: customerID})
+	if err == nil {
+		return nil
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition, codes.Unauthenticated:
+		return errors.Wrap(domain.ErrCustomerNotAuthorized, status.Convert(err).Message())
+	default:
+		return errors.Wrap(err, "authorizing customer")
+	}
+}
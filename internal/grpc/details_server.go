@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/queries"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type detailsServer struct {
+	app application.App
+	detailspb.UnimplementedOrderDetailsServiceServer
+}
+
+var _ detailspb.OrderDetailsServiceServer = (*detailsServer)(nil)
+
+func RegisterDetailsServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	detailspb.RegisterOrderDetailsServiceServer(registrar, detailsServer{app: app})
+	return nil
+}
+
+func (s detailsServer) GetOrderDetails(ctx context.Context, request *detailspb.GetOrderDetailsRequest) (*detailspb.GetOrderDetailsResponse, error) {
+	order, err := s.app.GetOrderDetails(ctx, queries.GetOrderDetails{ID: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &detailspb.GetOrderDetailsResponse{
+		Order: s.orderFromDomain(order),
+	}, nil
+}
+
+func (s detailsServer) orderFromDomain(order *domain.Order) *detailspb.OrderDetails {
+	items := make([]*detailspb.OrderDetails_Item, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &detailspb.OrderDetails_Item{
+			StoreId:     item.StoreID,
+			ProductId:   item.ProductID,
+			StoreName:   item.StoreName,
+			ProductName: item.ProductName,
+			Price:       item.Price.Amount,
+			Quantity:    int32(item.Quantity),
+		}
+	}
+
+	discounts := make([]*detailspb.OrderDetails_Discount, len(order.Discounts))
+	for i, discount := range order.Discounts {
+		discounts[i] = &detailspb.OrderDetails_Discount{
+			PromotionId: discount.PromotionID,
+			Description: discount.Description,
+			Amount:      discount.Amount.Amount,
+		}
+	}
+
+	taxes := make([]*detailspb.OrderDetails_Tax, len(order.Taxes))
+	for i, tax := range order.Taxes {
+		taxes[i] = &detailspb.OrderDetails_Tax{
+			ProductId:   tax.ProductID,
+			StoreId:     tax.StoreID,
+			Category:    tax.Category,
+			BasisPoints: tax.BasisPoints,
+			Amount:      tax.Amount.Amount,
+		}
+	}
+
+	total := order.GetTotal()
+
+	return &detailspb.OrderDetails{
+		Id:            order.ID(),
+		CustomerId:    order.CustomerID,
+		PaymentId:     order.PaymentID,
+		Status:        order.Status.String(),
+		Items:         items,
+		Currency:      total.Currency,
+		Subtotal:      order.GetSubtotal().Amount,
+		Discounts:     discounts,
+		DiscountTotal: order.GetDiscountTotal().Amount,
+		Total:         total.Amount,
+		Taxes:         taxes,
+		TaxTotal:      order.GetTaxTotal().Amount,
+		Returns:       returnsFromDomain(order.Returns),
+	}
+}
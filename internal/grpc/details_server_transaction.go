@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
+)
+
+type detailsServerTx struct {
+	c di.Container
+	detailspb.UnimplementedOrderDetailsServiceServer
+}
+
+var _ detailspb.OrderDetailsServiceServer = (*detailsServerTx)(nil)
+
+func (s detailsServerTx) GetOrderDetails(ctx context.Context, request *detailspb.GetOrderDetailsRequest) (resp *detailspb.GetOrderDetailsResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := detailsServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.GetOrderDetails(ctx, request)
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+)
+
+type fulfillmentServer struct {
+	app application.App
+	fulfillmentpb.UnimplementedOrderFulfillmentServiceServer
+}
+
+var _ fulfillmentpb.OrderFulfillmentServiceServer = (*fulfillmentServer)(nil)
+
+func RegisterFulfillmentServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	fulfillmentpb.RegisterOrderFulfillmentServiceServer(registrar, fulfillmentServer{app: app})
+	return nil
+}
+
+func (s fulfillmentServer) ReadyFulfillmentGroup(ctx context.Context, request *fulfillmentpb.ReadyFulfillmentGroupRequest) (*fulfillmentpb.ReadyFulfillmentGroupResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ReadyFulfillmentGroup(ctx, commands.ReadyFulfillmentGroup{
+		ID:              request.GetId(),
+		StoreID:         request.GetStoreId(),
+		ExpectedVersion: version,
+	})
+
+	return &fulfillmentpb.ReadyFulfillmentGroupResponse{}, err
+}
+
+func (s fulfillmentServer) HandOverFulfillmentGroup(ctx context.Context, request *fulfillmentpb.HandOverFulfillmentGroupRequest) (*fulfillmentpb.HandOverFulfillmentGroupResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.HandOverFulfillmentGroup(ctx, commands.HandOverFulfillmentGroup{
+		ID:              request.GetId(),
+		StoreID:         request.GetStoreId(),
+		ExpectedVersion: version,
+	})
+
+	return &fulfillmentpb.HandOverFulfillmentGroupResponse{}, err
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+)
+
+type fulfillmentServerTx struct {
+	c di.Container
+	fulfillmentpb.UnimplementedOrderFulfillmentServiceServer
+}
+
+var _ fulfillmentpb.OrderFulfillmentServiceServer = (*fulfillmentServerTx)(nil)
+
+func (s fulfillmentServerTx) ReadyFulfillmentGroup(ctx context.Context, request *fulfillmentpb.ReadyFulfillmentGroupRequest) (resp *fulfillmentpb.ReadyFulfillmentGroupResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := fulfillmentServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ReadyFulfillmentGroup(ctx, request)
+}
+
+func (s fulfillmentServerTx) HandOverFulfillmentGroup(ctx context.Context, request *fulfillmentpb.HandOverFulfillmentGroupRequest) (resp *fulfillmentpb.HandOverFulfillmentGroupResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := fulfillmentServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.HandOverFulfillmentGroup(ctx, request)
+}
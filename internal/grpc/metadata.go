@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/stackus/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// expectedVersionKey is the metadata a client sets to have a command refused when
+// the order changed since the client read it; REST clients send the
+// Expected-Version header.
+const expectedVersionKey = "expected-version"
+
+func expectedVersion(ctx context.Context) (int, error) {
+	values := metadata.ValueFromIncomingContext(ctx, expectedVersionKey)
+	if len(values) == 0 || values[0] == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(values[0])
+	if err != nil || version < 1 {
+		return 0, errors.ErrBadRequest.Msgf("the expected version %q is not a positive number", values[0])
+	}
+
+	return version, nil
+}
+
+// idempotencyKeyKey is the metadata a client sets so that a retried CreateOrder
+// returns the order created by the first attempt; REST clients send the
+// Idempotency-Key header.
+const idempotencyKeyKey = "idempotency-key"
+
+func idempotencyKey(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// couponCodesKey is the metadata a client sets to present coupon codes with
+// CreateOrder, once per code or as a comma separated list; REST clients send the
+// Coupon-Codes header.
+const couponCodesKey = "coupon-codes"
+
+func couponCodes(ctx context.Context) []string {
+	var codes []string
+	for _, value := range metadata.ValueFromIncomingContext(ctx, couponCodesKey) {
+		for _, code := range strings.Split(value, ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// requestHash identifies a request by its content and the metadata that changes
+// what the request does.
+func requestHash(request proto.Message, values ...string) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", errors.Wrap(err, "hashing request")
+	}
+
+	hash := sha256.New()
+	hash.Write(data)
+	for _, value := range values {
+		hash.Write([]byte{0})
+		hash.Write([]byte(value))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
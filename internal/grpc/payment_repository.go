@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackus/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/paymentspb"
+)
+
+type PaymentRepository struct {
+	client       paymentspb.PaymentsServiceClient
+	timeout      time.Duration
+	retries      int
+	retryBackoff time.Duration
+}
+
+var _ domain.PaymentRepository = (*PaymentRepository)(nil)
+
+func NewPaymentRepository(conn *grpc.ClientConn, timeout time.Duration, retries int, retryBackoff time.Duration) PaymentRepository {
+	return PaymentRepository{
+		client:       paymentspb.NewPaymentsServiceClient(conn),
+		timeout:      timeout,
+		retries:      retries,
+		retryBackoff: retryBackoff,
+	}
+}
+
+func (r PaymentRepository) Confirm(ctx context.Context, paymentID string) error {
+	err := r.invoke(ctx, func(ctx context.Context) error {
+		_, err := r.client.ConfirmPayment(ctx, &paymentspb.ConfirmPaymentRequest{Id: paymentID})
+		return err
+	})
+	if err == nil {
+		return nil
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition, codes.InvalidArgument:
+		return errors.Wrap(domain.ErrPaymentNotConfirmed, status.Convert(err).Message())
+	default:
+		return errors.Wrap(err, "confirming payment")
+	}
+}
+
+func (r PaymentRepository) Void(ctx context.Context, paymentID string) error {
+	err := r.invoke(ctx, func(ctx context.Context) error {
+		_, err := r.client.VoidPayment(ctx, &paymentspb.VoidPaymentRequest{Id: paymentID})
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "voiding payment")
+	}
+	return nil
+}
+
+// invoke retries calls that failed for transient reasons, waiting a little longer
+// before each new attempt.
+func (r PaymentRepository) invoke(ctx context.Context, call func(ctx context.Context) error) (err error) {
+	for attempt := 0; attempt <= r.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * r.retryBackoff):
+			}
+		}
+
+		if err = r.call(ctx, call); !r.isTransient(err) {
+			break
+		}
+	}
+
+	return err
+}
+
+func (r PaymentRepository) call(ctx context.Context, call func(ctx context.Context) error) error {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	return call(ctx)
+}
+
+func (r PaymentRepository) isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
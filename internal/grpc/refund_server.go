@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+)
+
+type refundServer struct {
+	app application.App
+	refundspb.UnimplementedOrderRefundServiceServer
+}
+
+var _ refundspb.OrderRefundServiceServer = (*refundServer)(nil)
+
+func RegisterRefundServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	refundspb.RegisterOrderRefundServiceServer(registrar, refundServer{app: app})
+	return nil
+}
+
+func (s refundServer) RefundOrder(ctx context.Context, request *refundspb.RefundOrderRequest) (*refundspb.RefundOrderResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []domain.RefundLine
+	for _, line := range request.GetLines() {
+		lines = append(lines, domain.RefundLine{
+			ProductID: line.GetProductId(),
+			Quantity:  int(line.GetQuantity()),
+		})
+	}
+
+	err = s.app.RefundOrder(ctx, commands.RefundOrder{
+		ID:              request.GetId(),
+		Reason:          request.GetReason(),
+		Lines:           lines,
+		ExpectedVersion: version,
+	})
+
+	return &refundspb.RefundOrderResponse{}, err
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+)
+
+type refundServerTx struct {
+	c di.Container
+	refundspb.UnimplementedOrderRefundServiceServer
+}
+
+var _ refundspb.OrderRefundServiceServer = (*refundServerTx)(nil)
+
+func (s refundServerTx) RefundOrder(ctx context.Context, request *refundspb.RefundOrderRequest) (resp *refundspb.RefundOrderResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := refundServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.RefundOrder(ctx, request)
+}
@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/application/queries"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+)
+
+type returnServer struct {
+	app application.App
+	returnspb.UnimplementedOrderReturnServiceServer
+}
+
+var _ returnspb.OrderReturnServiceServer = (*returnServer)(nil)
+
+func RegisterReturnServer(app application.App, registrar grpc.ServiceRegistrar) error {
+	returnspb.RegisterOrderReturnServiceServer(registrar, returnServer{app: app})
+	return nil
+}
+
+func (s returnServer) RequestReturn(ctx context.Context, request *returnspb.RequestReturnRequest) (*returnspb.RequestReturnResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]domain.ReturnLine, len(request.GetLines()))
+	for i, line := range request.GetLines() {
+		lines[i] = domain.ReturnLine{
+			ProductID: line.GetProductId(),
+			Quantity:  int(line.GetQuantity()),
+		}
+	}
+
+	returnID := uuid.New().String()
+
+	err = s.app.RequestReturn(ctx, commands.RequestReturn{
+		ID:              request.GetId(),
+		ReturnID:        returnID,
+		Reason:          request.GetReason(),
+		Lines:           lines,
+		ExpectedVersion: version,
+	})
+
+	return &returnspb.RequestReturnResponse{ReturnId: returnID}, err
+}
+
+func (s returnServer) ApproveReturn(ctx context.Context, request *returnspb.ApproveReturnRequest) (*returnspb.ApproveReturnResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ApproveReturn(ctx, commands.ApproveReturn{
+		ID:              request.GetId(),
+		ReturnID:        request.GetReturnId(),
+		ExpectedVersion: version,
+	})
+
+	return &returnspb.ApproveReturnResponse{}, err
+}
+
+func (s returnServer) ReceiveReturn(ctx context.Context, request *returnspb.ReceiveReturnRequest) (*returnspb.ReceiveReturnResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ReceiveReturn(ctx, commands.ReceiveReturn{
+		ID:              request.GetId(),
+		ReturnID:        request.GetReturnId(),
+		ExpectedVersion: version,
+	})
+
+	return &returnspb.ReceiveReturnResponse{}, err
+}
+
+func (s returnServer) RejectReturn(ctx context.Context, request *returnspb.RejectReturnRequest) (*returnspb.RejectReturnResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.RejectReturn(ctx, commands.RejectReturn{
+		ID:              request.GetId(),
+		ReturnID:        request.GetReturnId(),
+		Reason:          request.GetReason(),
+		ExpectedVersion: version,
+	})
+
+	return &returnspb.RejectReturnResponse{}, err
+}
+
+func (s returnServer) ListReturns(ctx context.Context, request *returnspb.ListReturnsRequest) (*returnspb.ListReturnsResponse, error) {
+	returns, err := s.app.ListReturns(ctx, queries.ListReturns{OrderID: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &returnspb.ListReturnsResponse{
+		Returns: returnsFromDomain(returns),
+	}, nil
+}
+
+func returnsFromDomain(returns []domain.Return) []*returnspb.OrderReturn {
+	orderReturns := make([]*returnspb.OrderReturn, len(returns))
+	for i, r := range returns {
+		lines := make([]*returnspb.OrderReturn_Line, len(r.Lines))
+		for j, line := range r.Lines {
+			lines[j] = &returnspb.OrderReturn_Line{
+				ProductId: line.ProductID,
+				Quantity:  int32(line.Quantity),
+			}
+		}
+		orderReturns[i] = &returnspb.OrderReturn{
+			Id:              r.ID,
+			Reason:          r.Reason,
+			Lines:           lines,
+			Status:          r.Status.String(),
+			RejectionReason: r.RejectionReason,
+		}
+	}
+	return orderReturns
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+)
+
+type returnServerTx struct {
+	c di.Container
+	returnspb.UnimplementedOrderReturnServiceServer
+}
+
+var _ returnspb.OrderReturnServiceServer = (*returnServerTx)(nil)
+
+func (s returnServerTx) RequestReturn(ctx context.Context, request *returnspb.RequestReturnRequest) (resp *returnspb.RequestReturnResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := returnServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.RequestReturn(ctx, request)
+}
+
+func (s returnServerTx) ApproveReturn(ctx context.Context, request *returnspb.ApproveReturnRequest) (resp *returnspb.ApproveReturnResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := returnServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ApproveReturn(ctx, request)
+}
+
+func (s returnServerTx) ReceiveReturn(ctx context.Context, request *returnspb.ReceiveReturnRequest) (resp *returnspb.ReceiveReturnResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := returnServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ReceiveReturn(ctx, request)
+}
+
+func (s returnServerTx) RejectReturn(ctx context.Context, request *returnspb.RejectReturnRequest) (resp *returnspb.RejectReturnResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := returnServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.RejectReturn(ctx, request)
+}
+
+func (s returnServerTx) ListReturns(ctx context.Context, request *returnspb.ListReturnsRequest) (resp *returnspb.ListReturnsResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := returnServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.ListReturns(ctx, request)
+}
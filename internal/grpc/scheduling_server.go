@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
+)
+
+type schedulingServer struct {
+	app  application.App
+	keys domain.IdempotencyKeyRepository
+	schedulingpb.UnimplementedOrderSchedulingServiceServer
+}
+
+var _ schedulingpb.OrderSchedulingServiceServer = (*schedulingServer)(nil)
+
+func RegisterSchedulingServer(app application.App, keys domain.IdempotencyKeyRepository, registrar grpc.ServiceRegistrar) error {
+	schedulingpb.RegisterOrderSchedulingServiceServer(registrar, schedulingServer{app: app, keys: keys})
+	return nil
+}
+
+// CreateScheduledOrder creates an order like CreateOrder does, with the time slot
+// and location it is handed over in.
+func (s schedulingServer) CreateScheduledOrder(ctx context.Context, request *schedulingpb.CreateScheduledOrderRequest) (*schedulingpb.CreateScheduledOrderResponse, error) {
+	items := make([]domain.Item, len(request.Items))
+	for i, item := range request.Items {
+		items[i] = domain.Item{
+			ProductID:   item.GetProductId(),
+			StoreID:     item.GetStoreId(),
+			StoreName:   item.GetStoreName(),
+			ProductName: item.GetProductName(),
+			Price:       domain.MoneyFromFloat(item.GetPrice(), domain.DefaultCurrency),
+			Quantity:    int(item.GetQuantity()),
+		}
+	}
+
+	id, err := createOrder(ctx, s.app, s.keys, request, commands.CreateOrder{
+		CustomerID: request.GetCustomerId(),
+		PaymentID:  request.GetPaymentId(),
+		Items:      items,
+		Fulfillment: domain.Fulfillment{
+			Method:     domain.FulfillmentMethod(request.GetFulfillment().GetMethod()),
+			LocationID: request.GetFulfillment().GetLocationId(),
+			Slot:       s.slotToDomain(request.GetFulfillment().GetSlot()),
+		},
+	})
+	if id == "" {
+		return nil, err
+	}
+
+	return &schedulingpb.CreateScheduledOrderResponse{Id: id}, err
+}
+
+func (s schedulingServer) RescheduleOrder(ctx context.Context, request *schedulingpb.RescheduleOrderRequest) (*schedulingpb.RescheduleOrderResponse, error) {
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.RescheduleOrder(ctx, commands.RescheduleOrder{
+		ID:              request.GetId(),
+		Slot:            s.slotToDomain(request.GetSlot()),
+		ExpectedVersion: version,
+	})
+
+	return &schedulingpb.RescheduleOrderResponse{}, err
+}
+
+func (s schedulingServer) slotToDomain(slot *schedulingpb.TimeSlot) domain.TimeSlot {
+	if slot == nil {
+		return domain.TimeSlot{}
+	}
+	return domain.TimeSlot{
+		Start: slot.GetStart().AsTime(),
+		End:   slot.GetEnd().AsTime(),
+	}
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
+)
+
+func TestSchedulingServer_CreateScheduledOrder(t *testing.T) {
+	start := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(30 * time.Minute)
+
+	app := &orderApp{}
+	s := schedulingServer{app: app, keys: keyStore{}}
+
+	resp, err := s.CreateScheduledOrder(context.Background(), &schedulingpb.CreateScheduledOrderRequest{
+		CustomerId: "customer-1",
+		PaymentId:  "payment-1",
+		Items:      []*schedulingpb.ScheduledOrderItem{{ProductId: "product-1", StoreId: "store-1", Price: 12.5, Quantity: 2}},
+		Fulfillment: &schedulingpb.Fulfillment{
+			Method:     string(domain.PickupAtLocker),
+			LocationId: "locker-1",
+			Slot:       &schedulingpb.TimeSlot{Start: timestamppb.New(start), End: timestamppb.New(end)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(app.created) != 1 {
+		t.Fatalf("created %d orders, want 1", len(app.created))
+	}
+	if app.created[0].ID != resp.GetId() {
+		t.Errorf("created order %q, responded with %q", app.created[0].ID, resp.GetId())
+	}
+	want := domain.Fulfillment{
+		Method:     domain.PickupAtLocker,
+		LocationID: "locker-1",
+		Slot:       domain.TimeSlot{Start: start, End: end},
+	}
+	if got := app.created[0].Fulfillment; !reflect.DeepEqual(got, want) {
+		t.Errorf("fulfillment = %+v, want %+v", got, want)
+	}
+}
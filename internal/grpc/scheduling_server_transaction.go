@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
+)
+
+type schedulingServerTx struct {
+	c di.Container
+	schedulingpb.UnimplementedOrderSchedulingServiceServer
+}
+
+var _ schedulingpb.OrderSchedulingServiceServer = (*schedulingServerTx)(nil)
+
+func (s schedulingServerTx) CreateScheduledOrder(ctx context.Context, request *schedulingpb.CreateScheduledOrderRequest) (resp *schedulingpb.CreateScheduledOrderResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := schedulingServer{
+		app:  di.Get(ctx, "app").(application.App),
+		keys: di.Get(ctx, "idempotencyKeys").(domain.IdempotencyKeyRepository),
+	}
+
+	return next.CreateScheduledOrder(ctx, request)
+}
+
+func (s schedulingServerTx) RescheduleOrder(ctx context.Context, request *schedulingpb.RescheduleOrderRequest) (resp *schedulingpb.RescheduleOrderResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	next := schedulingServer{app: di.Get(ctx, "app").(application.App)}
+
+	return next.RescheduleOrder(ctx, request)
+}
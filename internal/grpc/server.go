@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/v8tix/mallbots-ordering-proto/pb"
 	"github.com/v8tix/mallbots-ordering/internal/application"
@@ -14,48 +15,104 @@ import (
 )
 
 type server struct {
-	app application.App
+	app  application.App
+	keys domain.IdempotencyKeyRepository
 	pb.UnimplementedOrderingServiceServer
 }
 
 var _ pb.OrderingServiceServer = (*server)(nil)
 
-func RegisterServer(app application.App, registrar grpc.ServiceRegistrar) error {
-	pb.RegisterOrderingServiceServer(registrar, server{app: app})
+func RegisterServer(app application.App, keys domain.IdempotencyKeyRepository, registrar grpc.ServiceRegistrar) error {
+	pb.RegisterOrderingServiceServer(registrar, server{app: app, keys: keys})
 	return nil
 }
 
 func (s server) CreateOrder(ctx context.Context, request *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
-	id := uuid.New().String()
-
 	items := make([]domain.Item, len(request.Items))
 	for i, item := range request.Items {
 		items[i] = s.itemToDomain(item)
 	}
 
-	err := s.app.CreateOrder(ctx, commands.CreateOrder{
-		ID:         id,
+	id, err := createOrder(ctx, s.app, s.keys, request, commands.CreateOrder{
 		CustomerID: request.GetCustomerId(),
 		PaymentID:  request.GetPaymentId(),
 		Items:      items,
 	})
+	if id == "" {
+		return nil, err
+	}
 
 	return &pb.CreateOrderResponse{Id: id}, err
 }
 
+// createOrder creates the order of cmd under a new id, along with the coupon codes
+// sent with the request. A request repeated with the same idempotency key is
+// answered with the order the first request created; the key is claimed in the
+// same transaction as the order is created in.
+func createOrder(ctx context.Context, app application.App, keys domain.IdempotencyKeyRepository, request proto.Message, cmd commands.CreateOrder) (string, error) {
+	cmd.ID = uuid.New().String()
+	cmd.CouponCodes = couponCodes(ctx)
+
+	if key := idempotencyKey(ctx); key != "" {
+		hash, err := requestHash(request, cmd.CouponCodes...)
+		if err != nil {
+			return "", err
+		}
+
+		claim, err := keys.Claim(ctx, key, hash, cmd.ID)
+		if err != nil {
+			return "", err
+		}
+
+		if claim.RequestHash != hash {
+			return "", domain.ErrIdempotencyKeyReused
+		}
+
+		if claim.OrderID != cmd.ID {
+			return claim.OrderID, nil
+		}
+	}
+
+	return cmd.ID, app.CreateOrder(ctx, cmd)
+}
+
 func (s server) CancelOrder(ctx context.Context, request *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
-	err := s.app.CancelOrder(ctx, commands.CancelOrder{ID: request.GetId()})
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.CancelOrder(ctx, commands.CancelOrder{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	})
 
 	return &pb.CancelOrderResponse{}, err
 }
 
 func (s server) ReadyOrder(ctx context.Context, request *pb.ReadyOrderRequest) (*pb.ReadyOrderResponse, error) {
-	err := s.app.ReadyOrder(ctx, commands.ReadyOrder{ID: request.GetId()})
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.ReadyOrder(ctx, commands.ReadyOrder{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	})
 	return &pb.ReadyOrderResponse{}, err
 }
 
 func (s server) CompleteOrder(ctx context.Context, request *pb.CompleteOrderRequest) (*pb.CompleteOrderResponse, error) {
-	err := s.app.CompleteOrder(ctx, commands.CompleteOrder{ID: request.GetId()})
+	version, err := expectedVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.CompleteOrder(ctx, commands.CompleteOrder{
+		ID:              request.GetId(),
+		ExpectedVersion: version,
+	})
 	return &pb.CompleteOrderResponse{}, err
 }
 
@@ -70,14 +127,14 @@ func (s server) GetOrder(ctx context.Context, request *pb.GetOrderRequest) (*pb.
 	}, nil
 }
 
-func (s server) orderFromDomain(order *domain.Order) *pb.Order {
+func (s server) orderFromDomain(order *domain.OrderView) *pb.Order {
 	items := make([]*pb.OrderingItem, len(order.Items))
 	for i, item := range order.Items {
 		items[i] = s.itemFromDomain(item)
 	}
 
 	return &pb.Order{
-		Id:         order.ID(),
+		Id:         order.OrderID,
 		CustomerId: order.CustomerID,
 		PaymentId:  order.PaymentID,
 		Items:      items,
@@ -91,7 +148,7 @@ func (s server) itemToDomain(item *pb.OrderingItem) domain.Item {
 		StoreID:     item.GetStoreId(),
 		StoreName:   item.GetStoreName(),
 		ProductName: item.GetProductName(),
-		Price:       item.GetPrice(),
+		Price:       domain.MoneyFromFloat(item.GetPrice(), domain.DefaultCurrency),
 		Quantity:    int(item.GetQuantity()),
 	}
 }
@@ -102,7 +159,7 @@ func (s server) itemFromDomain(item domain.Item) *pb.OrderingItem {
 		ProductId:   item.ProductID,
 		StoreName:   item.StoreName,
 		ProductName: item.ProductName,
-		Price:       item.Price,
+		Price:       item.Price.Float64(),
 		Quantity:    int32(item.Quantity),
 	}
 }
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackus/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// keyStore claims idempotency keys the way the idempotency key table would.
+type keyStore map[string]domain.IdempotencyKey
+
+func (s keyStore) Claim(_ context.Context, key, requestHash, orderID string) (domain.IdempotencyKey, error) {
+	if claim, exists := s[key]; exists {
+		return claim, nil
+	}
+	s[key] = domain.IdempotencyKey{Key: key, RequestHash: requestHash, OrderID: orderID}
+	return s[key], nil
+}
+
+func (s keyStore) DeleteExpired(context.Context, time.Time, int) (int, error) { return 0, nil }
+
+// orderApp records the orders it was asked to create.
+type orderApp struct {
+	application.App
+	created []commands.CreateOrder
+}
+
+func (a *orderApp) CreateOrder(_ context.Context, cmd commands.CreateOrder) error {
+	a.created = append(a.created, cmd)
+	return nil
+}
+
+func createOrderRequest(quantity int32) *pb.CreateOrderRequest {
+	return &pb.CreateOrderRequest{
+		CustomerId: "customer-1",
+		PaymentId:  "payment-1",
+		Items:      []*pb.OrderingItem{{ProductId: "product-1", StoreId: "store-1", Price: 12.5, Quantity: quantity}},
+	}
+}
+
+func withIdempotencyKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyKey, key))
+}
+
+func TestServer_CreateOrderIdempotencyKey(t *testing.T) {
+	tests := map[string]struct {
+		first     context.Context
+		second    context.Context
+		request   *pb.CreateOrderRequest
+		wantSame  bool
+		wantOrder int
+		wantErr   error
+	}{
+		"repeated request": {
+			first:     withIdempotencyKey("key-1"),
+			second:    withIdempotencyKey("key-1"),
+			request:   createOrderRequest(2),
+			wantSame:  true,
+			wantOrder: 1,
+		},
+		"key reused for another request": {
+			first:     withIdempotencyKey("key-1"),
+			second:    withIdempotencyKey("key-1"),
+			request:   createOrderRequest(3),
+			wantOrder: 1,
+			wantErr:   errors.ErrInvalidArgument,
+		},
+		"other key": {
+			first:     withIdempotencyKey("key-1"),
+			second:    withIdempotencyKey("key-2"),
+			request:   createOrderRequest(2),
+			wantOrder: 2,
+		},
+		"no key": {
+			first:     context.Background(),
+			second:    context.Background(),
+			request:   createOrderRequest(2),
+			wantOrder: 2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := &orderApp{}
+			s := server{app: app, keys: keyStore{}}
+
+			first, err := s.CreateOrder(tc.first, createOrderRequest(2))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			second, err := s.CreateOrder(tc.second, tc.request)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tc.wantErr)
+				}
+				if !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+					t.Errorf("err = %v, want %v", err, domain.ErrIdempotencyKeyReused)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if same := second.GetId() == first.GetId(); same != tc.wantSame {
+					t.Errorf("second order id %q, first %q; want the same id: %t", second.GetId(), first.GetId(), tc.wantSame)
+				}
+			}
+
+			if len(app.created) != tc.wantOrder {
+				t.Errorf("created %d orders, want %d", len(app.created), tc.wantOrder)
+			}
+		})
+	}
+}
@@ -8,7 +8,15 @@ import (
 
 	"github.com/v8tix/eda/di"
 	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/adminpb"
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
 	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
 )
 
 type serverTx struct {
@@ -22,16 +30,40 @@ func RegisterServerTx(container di.Container, registrar grpc.ServiceRegistrar) e
 	pb.RegisterOrderingServiceServer(registrar, serverTx{
 		c: container,
 	})
+	detailspb.RegisterOrderDetailsServiceServer(registrar, detailsServerTx{
+		c: container,
+	})
+	amendmentspb.RegisterOrderAmendmentServiceServer(registrar, amendmentServerTx{
+		c: container,
+	})
+	adminpb.RegisterOrderAdminServiceServer(registrar, adminServerTx{
+		c: container,
+	})
+	schedulingpb.RegisterOrderSchedulingServiceServer(registrar, schedulingServerTx{
+		c: container,
+	})
+	refundspb.RegisterOrderRefundServiceServer(registrar, refundServerTx{
+		c: container,
+	})
+	returnspb.RegisterOrderReturnServiceServer(registrar, returnServerTx{
+		c: container,
+	})
+	fulfillmentpb.RegisterOrderFulfillmentServiceServer(registrar, fulfillmentServerTx{
+		c: container,
+	})
 	return nil
 }
 
 func (s serverTx) CreateOrder(ctx context.Context, request *pb.CreateOrderRequest) (resp *pb.CreateOrderResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
-		err = s.closeTx(tx, err)
+		err = closeTx(tx, err)
 	}(di.Get(ctx, "tx").(*sql.Tx))
 
-	next := server{app: di.Get(ctx, "app").(application.App)}
+	next := server{
+		app:  di.Get(ctx, "app").(application.App),
+		keys: di.Get(ctx, "idempotencyKeys").(domain.IdempotencyKeyRepository),
+	}
 
 	return next.CreateOrder(ctx, request)
 }
@@ -39,7 +71,7 @@ func (s serverTx) CreateOrder(ctx context.Context, request *pb.CreateOrderReques
 func (s serverTx) GetOrder(ctx context.Context, request *pb.GetOrderRequest) (resp *pb.GetOrderResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
-		err = s.closeTx(tx, err)
+		err = closeTx(tx, err)
 	}(di.Get(ctx, "tx").(*sql.Tx))
 
 	next := server{app: di.Get(ctx, "app").(application.App)}
@@ -50,7 +82,7 @@ func (s serverTx) GetOrder(ctx context.Context, request *pb.GetOrderRequest) (re
 func (s serverTx) CancelOrder(ctx context.Context, request *pb.CancelOrderRequest) (resp *pb.CancelOrderResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
-		err = s.closeTx(tx, err)
+		err = closeTx(tx, err)
 	}(di.Get(ctx, "tx").(*sql.Tx))
 
 	next := server{app: di.Get(ctx, "app").(application.App)}
@@ -61,7 +93,7 @@ func (s serverTx) CancelOrder(ctx context.Context, request *pb.CancelOrderReques
 func (s serverTx) ReadyOrder(ctx context.Context, request *pb.ReadyOrderRequest) (resp *pb.ReadyOrderResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
-		err = s.closeTx(tx, err)
+		err = closeTx(tx, err)
 	}(di.Get(ctx, "tx").(*sql.Tx))
 
 	next := server{app: di.Get(ctx, "app").(application.App)}
@@ -72,7 +104,7 @@ func (s serverTx) ReadyOrder(ctx context.Context, request *pb.ReadyOrderRequest)
 func (s serverTx) CompleteOrder(ctx context.Context, request *pb.CompleteOrderRequest) (resp *pb.CompleteOrderResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
-		err = s.closeTx(tx, err)
+		err = closeTx(tx, err)
 	}(di.Get(ctx, "tx").(*sql.Tx))
 
 	next := server{app: di.Get(ctx, "app").(application.App)}
@@ -80,7 +112,7 @@ func (s serverTx) CompleteOrder(ctx context.Context, request *pb.CompleteOrderRe
 	return next.CompleteOrder(ctx, request)
 }
 
-func (s serverTx) closeTx(tx *sql.Tx, err error) error {
+func closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
 		panic(p)
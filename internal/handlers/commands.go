@@ -3,13 +3,29 @@ package handlers
 import (
 	"context"
 
+	"github.com/stackus/errors"
+
 	"github.com/v8tix/eda/am"
 	"github.com/v8tix/eda/ddd"
 	"github.com/v8tix/mallbots-ordering-proto/pb"
 	"github.com/v8tix/mallbots-ordering/internal/application"
 	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
 )
 
+// refusals are the kinds of error with which the application refuses a command for
+// good. Only refusals are answered with a failure reply; any other error is taken to
+// be transient, and the command is left to be redelivered.
+var refusals = []error{
+	errors.ErrBadRequest,
+	errors.ErrInvalidArgument,
+	errors.ErrNotFound,
+	errors.ErrAlreadyExists,
+	errors.ErrPermissionDenied,
+	errors.ErrFailedPrecondition,
+	errors.ErrResourceExhausted,
+}
+
 type commandHandlers struct {
 	app application.App
 }
@@ -24,6 +40,11 @@ func RegisterCommandHandlers(subscriber am.RawMessageSubscriber, handlers am.Raw
 	return subscriber.Subscribe(pb.CommandChannel, handlers, am.MessageFilter{
 		pb.RejectOrderCommand,
 		pb.ApproveOrderCommand,
+		sagas.AuthorizeCustomerCommand,
+		sagas.ConfirmPaymentCommand,
+		sagas.VoidPaymentCommand,
+		sagas.CompleteCancellationCommand,
+		sagas.AbortCancellationCommand,
 	}, am.GroupName("ordering-commands"))
 }
 
@@ -33,6 +54,16 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (dd
 		return h.doRejectOrder(ctx, cmd)
 	case pb.ApproveOrderCommand:
 		return h.doApproveOrder(ctx, cmd)
+	case sagas.AuthorizeCustomerCommand:
+		return h.doAuthorizeCustomer(ctx, cmd)
+	case sagas.ConfirmPaymentCommand:
+		return h.doConfirmPayment(ctx, cmd)
+	case sagas.VoidPaymentCommand:
+		return h.doVoidPayment(ctx, cmd)
+	case sagas.CompleteCancellationCommand:
+		return h.doCompleteCancellation(ctx, cmd)
+	case sagas.AbortCancellationCommand:
+		return h.doAbortCancellation(ctx, cmd)
 	}
 
 	return nil, nil
@@ -52,3 +83,42 @@ func (h commandHandlers) doApproveOrder(ctx context.Context, cmd ddd.Command) (d
 		ShoppingID: payload.GetShoppingId(),
 	})
 }
+
+func (h commandHandlers) doAuthorizeCustomer(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*sagas.AuthorizeCustomer)
+
+	return nil, h.app.AuthorizeCustomer(ctx, commands.AuthorizeCustomer{ID: payload.OrderID})
+}
+
+func (h commandHandlers) doConfirmPayment(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*sagas.ConfirmPayment)
+
+	return nil, h.app.ConfirmPayment(ctx, commands.ConfirmPayment{ID: payload.OrderID})
+}
+
+func (h commandHandlers) doVoidPayment(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*sagas.VoidPayment)
+
+	return nil, h.app.VoidPayment(ctx, commands.VoidPayment{ID: payload.OrderID})
+}
+
+func (h commandHandlers) doCompleteCancellation(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*sagas.CompleteCancellation)
+
+	return nil, h.app.CompleteCancellation(ctx, commands.CompleteCancellation{ID: payload.OrderID})
+}
+
+func (h commandHandlers) doAbortCancellation(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*sagas.AbortCancellation)
+
+	return nil, h.app.AbortCancellation(ctx, commands.AbortCancellation{ID: payload.OrderID})
+}
+
+func isRefusal(err error) bool {
+	for _, refusal := range refusals {
+		if errors.Is(err, refusal) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/v8tix/eda/ddd"
 	"github.com/v8tix/eda/di"
 	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
 )
 
 func RegisterCommandHandlersTx(container di.Container) error {
@@ -24,11 +25,16 @@ func RegisterCommandHandlersTx(container di.Container) error {
 			}
 		}(di.Get(ctx, "tx").(*sql.Tx))
 
+		replies := &refusalReplies{
+			handler:   di.Get(ctx, "commandHandlers").(ddd.CommandHandler[ddd.Command]),
+			publisher: di.Get(ctx, "replyStream").(am.ReplyStream),
+		}
+
 		cmdMsgHandlers := am.RawMessageHandlerWithMiddleware(
 			am.NewCommandMessageHandler(
 				di.Get(ctx, "registry").(registry.Registry),
-				di.Get(ctx, "replyStream").(am.ReplyStream),
-				di.Get(ctx, "commandHandlers").(ddd.CommandHandler[ddd.Command]),
+				replies,
+				replies,
 			),
 			di.Get(ctx, "inboxMiddleware").(am.RawMessageHandlerMiddleware),
 		)
@@ -40,3 +46,34 @@ func RegisterCommandHandlersTx(container di.Container) error {
 
 	return RegisterCommandHandlers(subscriber, cmdMsgHandlers)
 }
+
+// refusalReplies stands between a command message handler and its command handler
+// and reply publisher. The message handler answers every failed command with a
+// failure reply; refusalReplies gives the reason of a refusal in a RefusedReply, and
+// withholds the reply of a command that failed for a transient reason and fails the
+// message with the error instead, so that the transaction is rolled back and the
+// command is redelivered.
+type refusalReplies struct {
+	handler   ddd.CommandHandler[ddd.Command]
+	publisher am.ReplyPublisher
+	err       error
+}
+
+func (r *refusalReplies) HandleCommand(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	reply, err := r.handler.HandleCommand(ctx, cmd)
+	switch {
+	case err == nil:
+	case !isRefusal(err):
+		r.err = err
+	case reply == nil:
+		reply = ddd.NewReply(sagas.RefusedReply, &sagas.Refused{Reason: err.Error()})
+	}
+	return reply, err
+}
+
+func (r *refusalReplies) Publish(ctx context.Context, topicName string, reply ddd.Reply) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.publisher.Publish(ctx, topicName, reply)
+}
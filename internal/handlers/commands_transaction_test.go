@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/am"
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
+)
+
+type failingCommands struct {
+	err error
+}
+
+func (h failingCommands) HandleCommand(context.Context, ddd.Command) (ddd.Reply, error) {
+	return nil, h.err
+}
+
+type replyRecorder []ddd.Reply
+
+func (r *replyRecorder) Publish(_ context.Context, _ string, reply ddd.Reply) error {
+	*r = append(*r, reply)
+	return nil
+}
+
+func TestRefusalReplies(t *testing.T) {
+	tests := map[string]struct {
+		err       error
+		wantReply bool
+	}{
+		"success":                 {wantReply: true},
+		"customer not authorized": {err: domain.ErrCustomerNotAuthorized, wantReply: true},
+		"payment declined":        {err: errors.Wrap(domain.ErrPaymentNotConfirmed, "card expired"), wantReply: true},
+		"order not found":         {err: domain.ErrOrderNotFound, wantReply: true},
+		"concurrent change":       {err: domain.ErrConcurrencyConflict},
+		"service unreachable":     {err: errors.Wrap(context.DeadlineExceeded, "confirming payment")},
+		"service unavailable":     {err: errors.ErrUnavailable.Msg("the payments service is unavailable")},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var published replyRecorder
+			replies := &refusalReplies{handler: failingCommands{err: tc.err}, publisher: &published}
+
+			reply, err := replies.HandleCommand(context.Background(), ddd.NewCommand("test.Command", nil))
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("err = %v, want %v", err, tc.err)
+			}
+			if tc.err != nil && tc.wantReply {
+				if reply == nil || reply.ReplyName() != sagas.RefusedReply {
+					t.Fatalf("reply = %v, want a %s reply", reply, sagas.RefusedReply)
+				}
+				if reason := reply.Payload().(*sagas.Refused).Reason; reason != tc.err.Error() {
+					t.Errorf("refusal reason = %q, want %q", reason, tc.err.Error())
+				}
+			}
+			if reply == nil {
+				reply = ddd.NewReply(am.FailureReply, nil)
+			}
+
+			err = replies.Publish(context.Background(), "replies", reply)
+			if tc.wantReply {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(published) != 1 {
+					t.Errorf("published %d replies, want 1", len(published))
+				}
+				return
+			}
+
+			if !errors.Is(err, tc.err) {
+				t.Errorf("publishing failed with %v, want %v", err, tc.err)
+			}
+			if len(published) != 0 {
+				t.Errorf("published %d replies, want none", len(published))
+			}
+		})
+	}
+}
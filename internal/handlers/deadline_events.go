@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// deadlineHandlers gives every new order a deadline to leave the pending status
+// and drops the deadline once it has. Holding a pending order keeps its deadline,
+// so an order nobody reviews in time still expires.
+type deadlineHandlers[T ddd.Event] struct {
+	deadlines domain.DeadlineRepository
+	timeout   time.Duration
+}
+
+func NewDeadlineEventHandlers(deadlines domain.DeadlineRepository, timeout time.Duration) ddd.EventHandler[ddd.Event] {
+	return deadlineHandlers[ddd.Event]{
+		deadlines: deadlines,
+		timeout:   timeout,
+	}
+}
+
+func RegisterDeadlineEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.OrderCreatedEvent,
+		domain.OrderApprovedEvent,
+		domain.OrderRejectedEvent,
+		domain.OrderCanceledEvent,
+		domain.OrderExpiredEvent,
+	)
+}
+
+func RegisterDeadlineEventHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		deadlineHandlers := di.Get(ctx, "deadlineEventHandlers").(ddd.EventHandler[ddd.Event])
+
+		return deadlineHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterDeadlineEventHandlers(subscriber, handlers)
+}
+
+func (h deadlineHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	order := event.Payload().(*domain.Order)
+	if event.EventName() == domain.OrderCreatedEvent {
+		return h.deadlines.Schedule(ctx, order.ID(), event.OccurredAt().Add(h.timeout))
+	}
+	return h.deadlines.Remove(ctx, order.ID())
+}
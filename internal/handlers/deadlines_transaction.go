@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/application/commands"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	defaultDeadlinePollInterval = 10 * time.Second
+	defaultDeadlineBatchSize    = 50
+)
+
+// DeadlineProcessor expires pending orders whose deadline has passed. Each order
+// is expired in its own transaction, which also holds the lock on its deadline,
+// so an order is expired once no matter how many instances are polling.
+type DeadlineProcessor struct {
+	container di.Container
+	interval  time.Duration
+	batchSize int
+	logger    zerolog.Logger
+}
+
+func NewDeadlineProcessor(container di.Container, interval time.Duration, batchSize int) DeadlineProcessor {
+	if interval <= 0 {
+		interval = defaultDeadlinePollInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultDeadlineBatchSize
+	}
+	return DeadlineProcessor{
+		container: container,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    container.Get("logger").(zerolog.Logger),
+	}
+}
+
+func (p DeadlineProcessor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.expireDue(ctx)
+		}
+	}
+}
+
+func (p DeadlineProcessor) expireDue(ctx context.Context) {
+	for i := 0; i < p.batchSize; i++ {
+		orderID, err := p.expireNext(ctx)
+		if orderID == "" && err == nil {
+			return
+		}
+		if err != nil {
+			p.logger.Error().Err(err).Str("OrderID", orderID).Msg("failed to expire order")
+			if orderID == "" {
+				return
+			}
+			// keep a failing order from being retried ahead of every other deadline
+			if err = p.postpone(ctx, orderID); err != nil {
+				p.logger.Error().Err(err).Str("OrderID", orderID).Msg("failed to postpone order deadline")
+				return
+			}
+		}
+	}
+}
+
+func (p DeadlineProcessor) expireNext(ctx context.Context) (orderID string, err error) {
+	ctx = p.container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = p.closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	deadlines := di.Get(ctx, "deadlines").(domain.DeadlineRepository)
+
+	orderIDs, err := deadlines.FindDue(ctx, time.Now(), 1)
+	if err != nil || len(orderIDs) == 0 {
+		return "", err
+	}
+	orderID = orderIDs[0]
+
+	err = di.Get(ctx, "app").(application.App).ExpireOrder(ctx, commands.ExpireOrder{ID: orderID})
+	// the order left the pending status without its deadline being dropped
+	if errors.As(err, &domain.ErrInvalidTransition{}) {
+		return orderID, deadlines.Remove(ctx, orderID)
+	}
+
+	return orderID, err
+}
+
+func (p DeadlineProcessor) postpone(ctx context.Context, orderID string) (err error) {
+	ctx = p.container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = p.closeTx(tx, err)
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	return di.Get(ctx, "deadlines").(domain.DeadlineRepository).Schedule(ctx, orderID, time.Now().Add(p.interval))
+}
+
+func (p DeadlineProcessor) closeTx(tx *sql.Tx, err error) error {
+	if r := recover(); r != nil {
+		_ = tx.Rollback()
+		panic(r)
+	} else if err != nil {
+		_ = tx.Rollback()
+		return err
+	} else {
+		return tx.Commit()
+	}
+}
@@ -3,10 +3,18 @@ package handlers
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/v8tix/eda/am"
 	"github.com/v8tix/eda/ddd"
 	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
 	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
 )
 
 type domainHandlers[T ddd.Event] struct {
@@ -20,11 +28,21 @@ func NewDomainEventHandlers(publisher am.MessagePublisher[ddd.Event]) ddd.EventH
 func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
 	subscriber.Subscribe(handlers,
 		domain.OrderCreatedEvent,
+		domain.OrderItemAddedEvent,
+		domain.OrderItemRemovedEvent,
+		domain.OrderItemQuantityChangedEvent,
 		domain.OrderRejectedEvent,
 		domain.OrderApprovedEvent,
 		domain.OrderReadiedEvent,
 		domain.OrderCanceledEvent,
 		domain.OrderCompletedEvent,
+		domain.FulfillmentGroupReadiedEvent,
+		domain.OrderExpiredEvent,
+		domain.OrderRescheduledEvent,
+		domain.OrderRefundedEvent,
+		domain.OrderPartiallyRefundedEvent,
+		domain.ReturnApprovedEvent,
+		domain.ReturnReceivedEvent,
 	)
 }
 
@@ -32,33 +50,111 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) error {
 	switch event.EventName() {
 	case domain.OrderCreatedEvent:
 		return h.onOrderCreated(ctx, event)
+	case domain.OrderItemAddedEvent, domain.OrderItemRemovedEvent, domain.OrderItemQuantityChangedEvent:
+		return h.onOrderAmended(ctx, event)
 	case domain.OrderReadiedEvent:
 		return h.onOrderReadied(ctx, event)
 	case domain.OrderCanceledEvent:
 		return h.onOrderCanceled(ctx, event)
 	case domain.OrderCompletedEvent:
 		return h.onOrderCompleted(ctx, event)
+	case domain.FulfillmentGroupReadiedEvent:
+		return h.onFulfillmentGroupReadied(ctx, event)
+	case domain.OrderExpiredEvent:
+		// expiry is announced as a cancellation so depot and payments drop their work
+		return h.onOrderCanceled(ctx, event)
+	case domain.OrderRescheduledEvent:
+		return h.onOrderRescheduled(ctx, event)
+	case domain.OrderRefundedEvent:
+		refund := event.Metadata().Get(domain.ChangeKey).(*domain.OrderRefunded)
+		return h.onOrderRefunded(ctx, event, refund.Reason, refund.Lines, refund.Amount, false)
+	case domain.OrderPartiallyRefundedEvent:
+		refund := event.Metadata().Get(domain.ChangeKey).(*domain.OrderPartiallyRefunded)
+		return h.onOrderRefunded(ctx, event, refund.Reason, refund.Lines, refund.Amount, true)
+	case domain.ReturnApprovedEvent:
+		return h.onReturnApproved(ctx, event)
+	case domain.ReturnReceivedEvent:
+		return h.onReturnReceived(ctx, event)
 	}
 	return nil
 }
 
+// onOrderCreated announces scheduled orders with their fulfillment. The message
+// is a superset of OrderCreated, so consumers that only know OrderCreated still
+// read it.
 func (h domainHandlers[T]) onOrderCreated(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*domain.Order)
-	items := make([]*pb.OrderCreated_Item, len(payload.Items))
+	if !payload.Fulfillment.IsScheduled() {
+		items := make([]*pb.OrderCreated_Item, len(payload.Items))
+		for i, item := range payload.Items {
+			items[i] = &pb.OrderCreated_Item{
+				ProductId: item.ProductID,
+				StoreId:   item.StoreID,
+				Price:     item.Price.Float64(),
+				Quantity:  int32(item.Quantity),
+			}
+		}
+		return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+			ddd.NewEvent(pb.OrderCreatedEvent, &pb.OrderCreated{
+				Id:         payload.ID(),
+				CustomerId: payload.CustomerID,
+				PaymentId:  payload.PaymentID,
+				ShoppingId: payload.ShoppingID,
+				Items:      items,
+			}),
+		)
+	}
+
+	items := make([]*schedulingpb.ScheduledOrderCreated_Item, len(payload.Items))
+	for i, item := range payload.Items {
+		items[i] = &schedulingpb.ScheduledOrderCreated_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Price:     item.Price.Float64(),
+			Quantity:  int32(item.Quantity),
+		}
+	}
+	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(pb.OrderCreatedEvent, &schedulingpb.ScheduledOrderCreated{
+			Id:          payload.ID(),
+			CustomerId:  payload.CustomerID,
+			PaymentId:   payload.PaymentID,
+			ShoppingId:  payload.ShoppingID,
+			Items:       items,
+			Fulfillment: fulfillmentFromDomain(payload.Fulfillment),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onOrderRescheduled(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(schedulingpb.OrderRescheduledEvent, &schedulingpb.OrderRescheduled{
+			Id:          payload.ID(),
+			CustomerId:  payload.CustomerID,
+			Fulfillment: fulfillmentFromDomain(payload.Fulfillment),
+		}),
+	)
+}
+
+// onOrderAmended announces the items of the order after the amendment so that
+// depot can bring a shopping list made for the order up to date.
+func (h domainHandlers[T]) onOrderAmended(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	items := make([]*amendmentspb.OrderAmended_Item, len(payload.Items))
 	for i, item := range payload.Items {
-		items[i] = &pb.OrderCreated_Item{
+		items[i] = &amendmentspb.OrderAmended_Item{
 			ProductId: item.ProductID,
 			StoreId:   item.StoreID,
-			Price:     item.Price,
+			Price:     item.Price.Float64(),
 			Quantity:  int32(item.Quantity),
 		}
 	}
 	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
-		ddd.NewEvent(pb.OrderCreatedEvent, &pb.OrderCreated{
+		ddd.NewEvent(amendmentspb.OrderAmendedEvent, &amendmentspb.OrderAmended{
 			Id:         payload.ID(),
 			CustomerId: payload.CustomerID,
 			PaymentId:  payload.PaymentID,
-			ShoppingId: payload.ShoppingID,
 			Items:      items,
 		}),
 	)
@@ -86,16 +182,51 @@ func (h domainHandlers[T]) onOrderApproved(ctx context.Context, event ddd.Event)
 	)
 }
 
+// onOrderReadied announces the breakdown of the total payments charges; the
+// message is a superset of OrderReadied.
 func (h domainHandlers[T]) onOrderReadied(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*domain.Order)
 	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
-		ddd.NewEvent(pb.OrderReadiedEvent, &pb.OrderReadied{
+		ddd.NewEvent(pb.OrderReadiedEvent, &detailspb.PricedOrderReadied{
+			Id:            payload.ID(),
+			CustomerId:    payload.CustomerID,
+			PaymentId:     payload.PaymentID,
+			Total:         payload.GetTotal().Float64(),
+			Subtotal:      payload.GetSubtotal().Float64(),
+			DiscountTotal: payload.GetDiscountTotal().Float64(),
+			TaxTotal:      payload.GetTaxTotal().Float64(),
+		}),
+	)
+}
+
+// onFulfillmentGroupReadied announces the group readied, and the order as readied
+// once the last of its store groups is.
+func (h domainHandlers[T]) onFulfillmentGroupReadied(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	readied := event.Metadata().Get(domain.ChangeKey).(*domain.FulfillmentGroupReadied)
+
+	var items []*fulfillmentpb.FulfillmentGroupReadied_Item
+	for _, item := range payload.FulfillmentGroupItems(readied.StoreID) {
+		items = append(items, &fulfillmentpb.FulfillmentGroupReadied_Item{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+
+	err := h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(fulfillmentpb.FulfillmentGroupReadiedEvent, &fulfillmentpb.FulfillmentGroupReadied{
 			Id:         payload.ID(),
-			CustomerId: payload.CustomerID,
-			PaymentId:  payload.PaymentID,
-			Total:      payload.GetTotal(),
+			StoreId:    readied.StoreID,
+			CustomerId: readied.CustomerID,
+			PaymentId:  readied.PaymentID,
+			Items:      items,
 		}),
 	)
+	if err != nil || payload.Status != domain.OrderIsReady {
+		return err
+	}
+
+	return h.onOrderReadied(ctx, event)
 }
 
 func (h domainHandlers[T]) onOrderCanceled(ctx context.Context, event ddd.Event) error {
@@ -119,3 +250,80 @@ func (h domainHandlers[T]) onOrderCompleted(ctx context.Context, event ddd.Event
 		}),
 	)
 }
+
+// onOrderRefunded announces the amount to pay back and the lines it was priced
+// from; lines are empty when the rest of the order was refunded at once.
+func (h domainHandlers[T]) onOrderRefunded(ctx context.Context, event ddd.Event, reason string, lines []domain.RefundLine, amount domain.Money, partial bool) error {
+	payload := event.Payload().(*domain.Order)
+	refunded := make([]*refundspb.OrderRefunded_Line, len(lines))
+	for i, line := range lines {
+		refunded[i] = &refundspb.OrderRefunded_Line{
+			ProductId: line.ProductID,
+			Quantity:  int32(line.Quantity),
+			Amount:    line.Amount.Float64(),
+		}
+	}
+	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(refundspb.OrderRefundedEvent, &refundspb.OrderRefunded{
+			Id:         payload.ID(),
+			CustomerId: payload.CustomerID,
+			PaymentId:  payload.PaymentID,
+			Reason:     reason,
+			Amount:     amount.Float64(),
+			Lines:      refunded,
+			Partial:    partial,
+		}),
+	)
+}
+
+// onReturnApproved asks depot to pick up the returned items from the customer.
+func (h domainHandlers[T]) onReturnApproved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	approved := event.Metadata().Get(domain.ChangeKey).(*domain.ReturnApproved)
+	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(returnspb.ReturnApprovedEvent, &returnspb.ReturnApproved{
+			Id:         payload.ID(),
+			ReturnId:   approved.ReturnID,
+			CustomerId: approved.CustomerID,
+			Items:      returnedItems(payload, approved.Lines),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onReturnReceived(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	received := event.Metadata().Get(domain.ChangeKey).(*domain.ReturnReceived)
+	return h.publisher.Publish(ctx, pb.OrderAggregateChannel,
+		ddd.NewEvent(returnspb.ReturnReceivedEvent, &returnspb.ReturnReceived{
+			Id:         payload.ID(),
+			ReturnId:   received.ReturnID,
+			CustomerId: received.CustomerID,
+			PaymentId:  received.PaymentID,
+			Items:      returnedItems(payload, received.Lines),
+		}),
+	)
+}
+
+func returnedItems(order *domain.Order, lines []domain.ReturnLine) []*returnspb.ReturnedItem {
+	items := make([]*returnspb.ReturnedItem, len(lines))
+	for i, line := range lines {
+		item, _ := order.FindItem(line.ProductID)
+		items[i] = &returnspb.ReturnedItem{
+			ProductId: line.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(line.Quantity),
+		}
+	}
+	return items
+}
+
+func fulfillmentFromDomain(fulfillment domain.Fulfillment) *schedulingpb.Fulfillment {
+	return &schedulingpb.Fulfillment{
+		Method:     fulfillment.Method.String(),
+		LocationId: fulfillment.LocationID,
+		Slot: &schedulingpb.TimeSlot{
+			Start: timestamppb.New(fulfillment.Slot.Start),
+			End:   timestamppb.New(fulfillment.Slot.End),
+		},
+	}
+}
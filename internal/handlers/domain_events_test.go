@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/detailspb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+)
+
+type eventRecorder []ddd.Event
+
+func (r *eventRecorder) Publish(_ context.Context, _ string, event ddd.Event) error {
+	*r = append(*r, event)
+	return nil
+}
+
+func completedOrder() *domain.Order {
+	order := domain.NewOrder("order-1")
+	order.CustomerID = "customer-1"
+	order.PaymentID = "payment-1"
+	order.Status = domain.OrderIsCompleted
+	order.Items = []domain.Item{
+		{ProductID: "product-1", StoreID: "store-1", Price: domain.NewMoney(1000, domain.DefaultCurrency), Quantity: 2},
+		{ProductID: "product-2", StoreID: "store-1", Price: domain.NewMoney(500, domain.DefaultCurrency), Quantity: 1},
+	}
+	return order
+}
+
+func TestDomainHandlers_OrderRefunded(t *testing.T) {
+	tests := map[string]struct {
+		lines       []domain.RefundLine
+		wantAmount  float64
+		wantLines   int
+		wantPartial bool
+	}{
+		"whole order": {
+			wantAmount: 25,
+		},
+		"some lines": {
+			lines:       []domain.RefundLine{{ProductID: "product-1", Quantity: 1}},
+			wantAmount:  10,
+			wantLines:   1,
+			wantPartial: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			event, err := completedOrder().Refund("damaged", tc.lines)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var published eventRecorder
+			if err = NewDomainEventHandlers(&published).HandleEvent(context.Background(), event); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(published) != 1 || published[0].EventName() != refundspb.OrderRefundedEvent {
+				t.Fatalf("published %v, want a single %s", published, refundspb.OrderRefundedEvent)
+			}
+			refund := published[0].Payload().(*refundspb.OrderRefunded)
+			if refund.GetPaymentId() != "payment-1" || refund.GetReason() != "damaged" {
+				t.Errorf("refund = %v, want the payment and reason of the refund", refund)
+			}
+			if refund.GetAmount() != tc.wantAmount {
+				t.Errorf("amount = %v, want %v", refund.GetAmount(), tc.wantAmount)
+			}
+			if len(refund.GetLines()) != tc.wantLines {
+				t.Errorf("lines = %v, want %d", refund.GetLines(), tc.wantLines)
+			}
+			if refund.GetPartial() != tc.wantPartial {
+				t.Errorf("partial = %t, want %t", refund.GetPartial(), tc.wantPartial)
+			}
+		})
+	}
+}
+
+func TestDomainHandlers_OrderReadied(t *testing.T) {
+	order := completedOrder()
+	order.Discounts = []domain.Discount{{PromotionID: "promo-1", Amount: domain.NewMoney(300, domain.DefaultCurrency)}}
+	order.Taxes = []domain.LineTax{{ProductID: "product-1", Amount: domain.NewMoney(160, domain.DefaultCurrency)}}
+
+	var published eventRecorder
+	if err := NewDomainEventHandlers(&published).HandleEvent(context.Background(), ddd.NewEvent(domain.OrderReadiedEvent, order)); err != nil {
+		t.Fatal(err)
+	}
+	if len(published) != 1 || published[0].EventName() != pb.OrderReadiedEvent {
+		t.Fatalf("published %v, want a single %s", published, pb.OrderReadiedEvent)
+	}
+
+	readied := published[0].Payload().(*detailspb.PricedOrderReadied)
+	if readied.GetSubtotal() != 25 || readied.GetDiscountTotal() != 3 || readied.GetTaxTotal() != 1.6 || readied.GetTotal() != 23.6 {
+		t.Errorf("readied = %v, want subtotal 25, discount 3, tax 1.6 and total 23.6", readied)
+	}
+
+	// consumers that only know OrderReadied read the same message
+	data, err := proto.Marshal(readied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plain pb.OrderReadied
+	if err = proto.Unmarshal(data, &plain); err != nil {
+		t.Fatal(err)
+	}
+	if plain.GetId() != "order-1" || plain.GetTotal() != readied.GetTotal() {
+		t.Errorf("OrderReadied = %v, want the id and total of %v", &plain, readied)
+	}
+}
+
+func TestDomainHandlers_ReturnReceived(t *testing.T) {
+	order := completedOrder()
+	order.Returns = []domain.Return{{
+		ID:     "return-1",
+		Lines:  []domain.ReturnLine{{ProductID: "product-2", Quantity: 1}},
+		Status: domain.ReturnIsApproved,
+	}}
+
+	event, err := order.ReceiveReturn("return-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var published eventRecorder
+	if err = NewDomainEventHandlers(&published).HandleEvent(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	if len(published) != 1 || published[0].EventName() != returnspb.ReturnReceivedEvent {
+		t.Fatalf("published %v, want a single %s", published, returnspb.ReturnReceivedEvent)
+	}
+
+	received := published[0].Payload().(*returnspb.ReturnReceived)
+	if received.GetReturnId() != "return-1" || received.GetPaymentId() != "payment-1" {
+		t.Errorf("received = %v, want return-1 paid with payment-1", received)
+	}
+	if items := received.GetItems(); len(items) != 1 || items[0].GetStoreId() != "store-1" || items[0].GetQuantity() != 1 {
+		t.Errorf("items = %v, want one product-2 from store-1", items)
+	}
+}
+
+func TestDomainHandlers_FulfillmentGroupReadied(t *testing.T) {
+	tests := map[string]struct {
+		otherGroup domain.FulfillmentStatus
+		want       []string
+	}{
+		"other store still picking": {
+			otherGroup: domain.FulfillmentIsPicking,
+			want:       []string{fulfillmentpb.FulfillmentGroupReadiedEvent},
+		},
+		"last store": {
+			otherGroup: domain.FulfillmentIsReady,
+			want:       []string{fulfillmentpb.FulfillmentGroupReadiedEvent, pb.OrderReadiedEvent},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := completedOrder()
+			order.Status = domain.OrderIsInProcess
+			order.Items = append(order.Items, domain.Item{
+				ProductID: "product-3", StoreID: "store-2", Price: domain.NewMoney(700, domain.DefaultCurrency), Quantity: 1,
+			})
+			order.FulfillmentGroups = []domain.FulfillmentGroup{
+				{StoreID: "store-1", Status: domain.FulfillmentIsPicking},
+				{StoreID: "store-2", Status: tc.otherGroup},
+			}
+
+			event, err := order.ReadyFulfillmentGroup("store-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the repository applies the pending events before the handlers run
+			for _, pending := range order.Events() {
+				if err = order.ApplyEvent(pending); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var published eventRecorder
+			if err = NewDomainEventHandlers(&published).HandleEvent(context.Background(), event); err != nil {
+				t.Fatal(err)
+			}
+			if len(published) != len(tc.want) {
+				t.Fatalf("published %v, want %v", published, tc.want)
+			}
+			for i, name := range tc.want {
+				if published[i].EventName() != name {
+					t.Errorf("published[%d] = %s, want %s", i, published[i].EventName(), name)
+				}
+			}
+
+			readied := published[0].Payload().(*fulfillmentpb.FulfillmentGroupReadied)
+			if readied.GetId() != "order-1" || readied.GetStoreId() != "store-1" || readied.GetPaymentId() != "payment-1" {
+				t.Errorf("readied = %v, want store-1 of order-1 paid with payment-1", readied)
+			}
+			if items := readied.GetItems(); len(items) != 2 || items[0].GetProductId() != "product-1" || items[1].GetProductId() != "product-2" {
+				t.Errorf("items = %v, want the two store-1 products", items)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// holdHandlers keeps the review queue in step with the orders placed on hold.
+type holdHandlers[T ddd.Event] struct {
+	held domain.HeldOrderRepository
+}
+
+func NewHoldEventHandlers(held domain.HeldOrderRepository) ddd.EventHandler[ddd.Event] {
+	return holdHandlers[ddd.Event]{held: held}
+}
+
+func RegisterHoldEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.OrderPlacedOnHoldEvent,
+		domain.OrderHoldReleasedEvent,
+		domain.OrderRejectedEvent,
+		domain.OrderCanceledEvent,
+		domain.OrderExpiredEvent,
+	)
+}
+
+func RegisterHoldEventHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		holdHandlers := di.Get(ctx, "holdEventHandlers").(ddd.EventHandler[ddd.Event])
+
+		return holdHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterHoldEventHandlers(subscriber, handlers)
+}
+
+func (h holdHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	order := event.Payload().(*domain.Order)
+	if event.EventName() != domain.OrderPlacedOnHoldEvent {
+		return h.held.Remove(ctx, order.ID())
+	}
+
+	return h.held.Add(ctx, domain.HeldOrder{
+		OrderID:    order.ID(),
+		CustomerID: order.CustomerID,
+		Reason:     order.HoldReason,
+		RiskScore:  order.HoldRiskScore,
+		Total:      order.GetTotal(),
+		HeldAt:     event.OccurredAt(),
+	})
+}
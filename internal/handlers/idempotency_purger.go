@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	defaultIdempotencyPurgeInterval  = time.Hour
+	defaultIdempotencyPurgeBatchSize = 1000
+)
+
+// IdempotencyKeyPurger deletes idempotency keys once they have expired. Claim
+// already takes over expired keys, so the purge only keeps keys that are never
+// sent again from piling up.
+type IdempotencyKeyPurger struct {
+	keys      domain.IdempotencyKeyRepository
+	interval  time.Duration
+	batchSize int
+	logger    zerolog.Logger
+}
+
+func NewIdempotencyKeyPurger(keys domain.IdempotencyKeyRepository, interval time.Duration, batchSize int, logger zerolog.Logger) IdempotencyKeyPurger {
+	if interval <= 0 {
+		interval = defaultIdempotencyPurgeInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultIdempotencyPurgeBatchSize
+	}
+	return IdempotencyKeyPurger{
+		keys:      keys,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+func (p IdempotencyKeyPurger) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.purgeExpired(ctx)
+		}
+	}
+}
+
+// purgeExpired deletes in batches, so that no single delete holds locks on a
+// large share of the table.
+func (p IdempotencyKeyPurger) purgeExpired(ctx context.Context) {
+	now := time.Now()
+	for ctx.Err() == nil {
+		deleted, err := p.keys.DeleteExpired(ctx, now, p.batchSize)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed to purge expired idempotency keys")
+			return
+		}
+		if deleted < p.batchSize {
+			return
+		}
+	}
+}
@@ -64,7 +64,7 @@ func (h integrationHandlers[T]) onBasketCheckedOut(ctx context.Context, event dd
 			StoreID:     item.GetStoreId(),
 			StoreName:   item.GetStoreName(),
 			ProductName: item.GetProductName(),
-			Price:       item.GetPrice(),
+			Price:       domain.MoneyFromFloat(item.GetPrice(), domain.DefaultCurrency),
 			Quantity:    int(item.GetQuantity()),
 		}
 	}
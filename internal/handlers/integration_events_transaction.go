@@ -4,37 +4,30 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/stackus/errors"
+
 	"github.com/v8tix/eda/am"
 	"github.com/v8tix/eda/ddd"
 	"github.com/v8tix/eda/di"
 	"github.com/v8tix/eda/registry"
 	basketspb "github.com/v8tix/mallbots-baskets-proto/pb"
 	depotpb "github.com/v8tix/mallbots-depot-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
 )
 
+// integrationEventAttempts bounds how often an event is handled again after the
+// order it changes was changed by someone else in the meantime.
+const integrationEventAttempts = 3
+
 func RegisterIntegrationEventHandlersTx(container di.Container) error {
 	evtMsgHandler := am.RawMessageHandlerFunc(func(ctx context.Context, msg am.IncomingRawMessage) (err error) {
-		ctx = container.Scoped(ctx)
-		defer func(tx *sql.Tx) {
-			if p := recover(); p != nil {
-				_ = tx.Rollback()
-				panic(p)
-			} else if err != nil {
-				_ = tx.Rollback()
-			} else {
-				err = tx.Commit()
+		for attempt := 1; ; attempt++ {
+			// each attempt reloads the order in a transaction of its own
+			err = handleIntegrationEventTx(container.Scoped(ctx), msg)
+			if err == nil || attempt == integrationEventAttempts || !errors.Is(err, domain.ErrConcurrencyConflict) {
+				return err
 			}
-		}(di.Get(ctx, "tx").(*sql.Tx))
-
-		evtHandlers := am.RawMessageHandlerWithMiddleware(
-			am.NewEventMessageHandler(
-				di.Get(ctx, "registry").(registry.Registry),
-				di.Get(ctx, "integrationEventHandlers").(ddd.EventHandler[ddd.Event]),
-			),
-			di.Get(ctx, "inboxMiddleware").(am.RawMessageHandlerMiddleware),
-		)
-
-		return evtHandlers.HandleMessage(ctx, msg)
+		}
 	})
 
 	subscriber := container.Get("stream").(am.RawMessageStream)
@@ -52,3 +45,26 @@ func RegisterIntegrationEventHandlersTx(container di.Container) error {
 
 	return err
 }
+
+func handleIntegrationEventTx(ctx context.Context, msg am.IncomingRawMessage) (err error) {
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, "tx").(*sql.Tx))
+
+	evtHandlers := am.RawMessageHandlerWithMiddleware(
+		am.NewEventMessageHandler(
+			di.Get(ctx, "registry").(registry.Registry),
+			di.Get(ctx, "integrationEventHandlers").(ddd.EventHandler[ddd.Event]),
+		),
+		di.Get(ctx, "inboxMiddleware").(am.RawMessageHandlerMiddleware),
+	)
+
+	return evtHandlers.HandleMessage(ctx, msg)
+}
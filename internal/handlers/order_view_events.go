@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// orderViewHandlers projects every change of an order into its read model, inside
+// the transaction that recorded the change.
+type orderViewHandlers[T ddd.Event] struct {
+	views domain.OrderViewRepository
+}
+
+func NewOrderViewEventHandlers(views domain.OrderViewRepository) ddd.EventHandler[ddd.Event] {
+	return orderViewHandlers[ddd.Event]{views: views}
+}
+
+func RegisterOrderViewEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.OrderCreatedEvent,
+		domain.OrderItemAddedEvent,
+		domain.OrderItemRemovedEvent,
+		domain.OrderItemQuantityChangedEvent,
+		domain.OrderRejectedEvent,
+		domain.OrderApprovedEvent,
+		domain.OrderCanceledEvent,
+		domain.OrderReadiedEvent,
+		domain.OrderCompletedEvent,
+		domain.OrderPartiallyRefundedEvent,
+		domain.OrderRefundedEvent,
+		domain.ReturnRequestedEvent,
+		domain.ReturnApprovedEvent,
+		domain.ReturnReceivedEvent,
+		domain.ReturnRejectedEvent,
+		domain.FulfillmentGroupReadiedEvent,
+		domain.FulfillmentGroupHandedOverEvent,
+		domain.OrderRescheduledEvent,
+		domain.OrderExpiredEvent,
+		domain.OrderPlacedOnHoldEvent,
+		domain.OrderHoldReleasedEvent,
+		domain.OrderPaymentConfirmedEvent,
+		domain.OrderPaymentFailedEvent,
+		domain.OrderCancellationStartedEvent,
+		domain.OrderCancellationAbortedEvent,
+	)
+}
+
+func RegisterOrderViewEventHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		orderViewHandlers := di.Get(ctx, "orderViewEventHandlers").(ddd.EventHandler[ddd.Event])
+
+		return orderViewHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterOrderViewEventHandlers(subscriber, handlers)
+}
+
+func (h orderViewHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	return h.views.Save(ctx, domain.NewOrderView(event.Payload().(*domain.Order)))
+}
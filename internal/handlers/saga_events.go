@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/eda/sec"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
+)
+
+// sagaHandlers starts the create order saga for pending orders, and the cancel
+// order saga for approved orders being cancelled. Orders held for review are
+// started once the hold is released.
+type sagaHandlers[T ddd.Event] struct {
+	createOrder sec.Orchestrator[*sagas.CreateOrderData]
+	cancelOrder sec.Orchestrator[*sagas.CancelOrderData]
+	sagas       domain.OrderSagaRepository
+}
+
+func NewSagaEventHandlers(createOrder sec.Orchestrator[*sagas.CreateOrderData], cancelOrder sec.Orchestrator[*sagas.CancelOrderData],
+	sagas domain.OrderSagaRepository,
+) ddd.EventHandler[ddd.Event] {
+	return sagaHandlers[ddd.Event]{
+		createOrder: createOrder,
+		cancelOrder: cancelOrder,
+		sagas:       sagas,
+	}
+}
+
+func RegisterSagaEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.OrderCreatedEvent,
+		domain.OrderHoldReleasedEvent,
+		domain.OrderCancellationStartedEvent,
+	)
+}
+
+func RegisterSagaEventHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		sagaHandlers := di.Get(ctx, "sagaEventHandlers").(ddd.EventHandler[ddd.Event])
+
+		return sagaHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterSagaEventHandlers(subscriber, handlers)
+}
+
+func (h sagaHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	switch event.EventName() {
+	case domain.OrderCreatedEvent:
+		return h.onOrderCreated(ctx, event)
+	case domain.OrderHoldReleasedEvent:
+		return h.onOrderHoldReleased(ctx, event)
+	case domain.OrderCancellationStartedEvent:
+		return h.onOrderCancellationStarted(ctx, event)
+	}
+	return nil
+}
+
+func (h sagaHandlers[T]) onOrderCreated(ctx context.Context, event ddd.Event) error {
+	order := event.Payload().(*domain.Order)
+	if order.Status != domain.OrderIsPending {
+		return nil
+	}
+
+	return h.startCreateOrder(ctx, order)
+}
+
+func (h sagaHandlers[T]) onOrderHoldReleased(ctx context.Context, event ddd.Event) error {
+	order := event.Payload().(*domain.Order)
+	if order.Status != domain.OrderIsPending {
+		return nil
+	}
+
+	// an order held while its saga was running is not started again
+	_, err := h.sagas.Find(ctx, order.ID())
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, domain.ErrOrderSagaNotFound) {
+		return err
+	}
+
+	return h.startCreateOrder(ctx, order)
+}
+
+func (h sagaHandlers[T]) onOrderCancellationStarted(ctx context.Context, event ddd.Event) error {
+	order := event.Payload().(*domain.Order)
+
+	return h.cancelOrder.Start(ctx, order.ID(), &sagas.CancelOrderData{
+		OrderID:    order.ID(),
+		PaymentID:  order.PaymentID,
+		ShoppingID: order.ShoppingID,
+	})
+}
+
+func (h sagaHandlers[T]) startCreateOrder(ctx context.Context, order *domain.Order) error {
+	return h.createOrder.Start(ctx, order.ID(), &sagas.CreateOrderData{
+		OrderID:    order.ID(),
+		CustomerID: order.CustomerID,
+		PaymentID:  order.PaymentID,
+		Items:      order.Items,
+	})
+}
@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"github.com/v8tix/eda/am"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
+)
+
+func RegisterSagaReplyHandlers(subscriber am.RawMessageSubscriber, createOrderHandlers, cancelOrderHandlers am.RawMessageHandler) error {
+	if err := subscriber.Subscribe(sagas.CreateOrderReplyChannel, createOrderHandlers, am.GroupName("ordering-saga-replies")); err != nil {
+		return err
+	}
+
+	return subscriber.Subscribe(sagas.CancelOrderReplyChannel, cancelOrderHandlers, am.GroupName("ordering-cancel-saga-replies"))
+}
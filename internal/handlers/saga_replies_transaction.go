@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/v8tix/eda/am"
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/eda/registry"
+)
+
+func RegisterSagaReplyHandlersTx(container di.Container) error {
+	subscriber := container.Get("stream").(am.RawMessageStream)
+
+	return RegisterSagaReplyHandlers(subscriber,
+		sagaReplyMsgHandlers(container, "createOrderSagaReplyHandlers"),
+		sagaReplyMsgHandlers(container, "cancelOrderSagaReplyHandlers"),
+	)
+}
+
+// sagaReplyMsgHandlers hands the replies to the reply handlers registered in the
+// container under the name, within a transaction of their own.
+func sagaReplyMsgHandlers(container di.Container, name string) am.RawMessageHandler {
+	return am.RawMessageHandlerFunc(func(ctx context.Context, msg am.IncomingRawMessage) (err error) {
+		ctx = container.Scoped(ctx)
+		defer func(tx *sql.Tx) {
+			if p := recover(); p != nil {
+				_ = tx.Rollback()
+				panic(p)
+			} else if err != nil {
+				_ = tx.Rollback()
+			} else {
+				err = tx.Commit()
+			}
+		}(di.Get(ctx, "tx").(*sql.Tx))
+
+		replyMsgHandlers := am.RawMessageHandlerWithMiddleware(
+			am.NewReplyMessageHandler(
+				di.Get(ctx, "registry").(registry.Registry),
+				di.Get(ctx, name).(ddd.ReplyHandler[ddd.Reply]),
+			),
+			di.Get(ctx, "inboxMiddleware").(am.RawMessageHandlerMiddleware),
+		)
+
+		return replyMsgHandlers.HandleMessage(ctx, msg)
+	})
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/di"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// slotHandlers frees the time slot of orders that will never be fulfilled.
+type slotHandlers[T ddd.Event] struct {
+	slots domain.SlotCapacity
+}
+
+func NewSlotEventHandlers(slots domain.SlotCapacity) ddd.EventHandler[ddd.Event] {
+	return slotHandlers[ddd.Event]{slots: slots}
+}
+
+func RegisterSlotEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.OrderRejectedEvent,
+		domain.OrderCanceledEvent,
+		domain.OrderExpiredEvent,
+	)
+}
+
+func RegisterSlotEventHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		slotHandlers := di.Get(ctx, "slotEventHandlers").(ddd.EventHandler[ddd.Event])
+
+		return slotHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterSlotEventHandlers(subscriber, handlers)
+}
+
+func (h slotHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	order := event.Payload().(*domain.Order)
+	if !order.Fulfillment.IsScheduled() {
+		return nil
+	}
+	return h.slots.Release(ctx, order.ID())
+}
@@ -31,12 +31,66 @@ func (a Application) CreateOrder(ctx context.Context, cmd commands.CreateOrder)
 	return a.App.CreateOrder(ctx, cmd)
 }
 
+func (a Application) AddOrderItem(ctx context.Context, cmd commands.AddOrderItem) (err error) {
+	a.logger.Info().Msg("--> Ordering.AddOrderItem")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.AddOrderItem") }()
+	return a.App.AddOrderItem(ctx, cmd)
+}
+
+func (a Application) RemoveOrderItem(ctx context.Context, cmd commands.RemoveOrderItem) (err error) {
+	a.logger.Info().Msg("--> Ordering.RemoveOrderItem")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.RemoveOrderItem") }()
+	return a.App.RemoveOrderItem(ctx, cmd)
+}
+
+func (a Application) ChangeItemQuantity(ctx context.Context, cmd commands.ChangeItemQuantity) (err error) {
+	a.logger.Info().Msg("--> Ordering.ChangeItemQuantity")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ChangeItemQuantity") }()
+	return a.App.ChangeItemQuantity(ctx, cmd)
+}
+
+func (a Application) RescheduleOrder(ctx context.Context, cmd commands.RescheduleOrder) (err error) {
+	a.logger.Info().Msg("--> Ordering.RescheduleOrder")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.RescheduleOrder") }()
+	return a.App.RescheduleOrder(ctx, cmd)
+}
+
 func (a Application) CancelOrder(ctx context.Context, cmd commands.CancelOrder) (err error) {
 	a.logger.Info().Msg("--> Ordering.CancelOrder")
 	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.CancelOrder") }()
 	return a.App.CancelOrder(ctx, cmd)
 }
 
+func (a Application) CompleteCancellation(ctx context.Context, cmd commands.CompleteCancellation) (err error) {
+	a.logger.Info().Msg("--> Ordering.CompleteCancellation")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.CompleteCancellation") }()
+	return a.App.CompleteCancellation(ctx, cmd)
+}
+
+func (a Application) AbortCancellation(ctx context.Context, cmd commands.AbortCancellation) (err error) {
+	a.logger.Info().Msg("--> Ordering.AbortCancellation")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.AbortCancellation") }()
+	return a.App.AbortCancellation(ctx, cmd)
+}
+
+func (a Application) ExpireOrder(ctx context.Context, cmd commands.ExpireOrder) (err error) {
+	a.logger.Info().Msg("--> Ordering.ExpireOrder")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ExpireOrder") }()
+	return a.App.ExpireOrder(ctx, cmd)
+}
+
+func (a Application) PlaceOnHold(ctx context.Context, cmd commands.PlaceOnHold) (err error) {
+	a.logger.Info().Msg("--> Ordering.PlaceOnHold")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.PlaceOnHold") }()
+	return a.App.PlaceOnHold(ctx, cmd)
+}
+
+func (a Application) ReleaseHold(ctx context.Context, cmd commands.ReleaseHold) (err error) {
+	a.logger.Info().Msg("--> Ordering.ReleaseHold")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ReleaseHold") }()
+	return a.App.ReleaseHold(ctx, cmd)
+}
+
 func (a Application) ReadyOrder(ctx context.Context, cmd commands.ReadyOrder) (err error) {
 	a.logger.Info().Msg("--> Ordering.ReadyOrder")
 	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ReadyOrder") }()
@@ -49,8 +103,122 @@ func (a Application) CompleteOrder(ctx context.Context, cmd commands.CompleteOrd
 	return a.App.CompleteOrder(ctx, cmd)
 }
 
-func (a Application) GetOrder(ctx context.Context, query queries.GetOrder) (order *domain.Order, err error) {
+func (a Application) ReadyFulfillmentGroup(ctx context.Context, cmd commands.ReadyFulfillmentGroup) (err error) {
+	a.logger.Info().Msg("--> Ordering.ReadyFulfillmentGroup")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ReadyFulfillmentGroup") }()
+	return a.App.ReadyFulfillmentGroup(ctx, cmd)
+}
+
+func (a Application) HandOverFulfillmentGroup(ctx context.Context, cmd commands.HandOverFulfillmentGroup) (err error) {
+	a.logger.Info().Msg("--> Ordering.HandOverFulfillmentGroup")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.HandOverFulfillmentGroup") }()
+	return a.App.HandOverFulfillmentGroup(ctx, cmd)
+}
+
+func (a Application) RefundOrder(ctx context.Context, cmd commands.RefundOrder) (err error) {
+	a.logger.Info().Msg("--> Ordering.RefundOrder")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.RefundOrder") }()
+	return a.App.RefundOrder(ctx, cmd)
+}
+
+func (a Application) RequestReturn(ctx context.Context, cmd commands.RequestReturn) (err error) {
+	a.logger.Info().Msg("--> Ordering.RequestReturn")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.RequestReturn") }()
+	return a.App.RequestReturn(ctx, cmd)
+}
+
+func (a Application) ApproveReturn(ctx context.Context, cmd commands.ApproveReturn) (err error) {
+	a.logger.Info().Msg("--> Ordering.ApproveReturn")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ApproveReturn") }()
+	return a.App.ApproveReturn(ctx, cmd)
+}
+
+func (a Application) ReceiveReturn(ctx context.Context, cmd commands.ReceiveReturn) (err error) {
+	a.logger.Info().Msg("--> Ordering.ReceiveReturn")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ReceiveReturn") }()
+	return a.App.ReceiveReturn(ctx, cmd)
+}
+
+func (a Application) RejectReturn(ctx context.Context, cmd commands.RejectReturn) (err error) {
+	a.logger.Info().Msg("--> Ordering.RejectReturn")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.RejectReturn") }()
+	return a.App.RejectReturn(ctx, cmd)
+}
+
+func (a Application) AuthorizeCustomer(ctx context.Context, cmd commands.AuthorizeCustomer) (err error) {
+	a.logger.Info().Msg("--> Ordering.AuthorizeCustomer")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.AuthorizeCustomer") }()
+	return a.App.AuthorizeCustomer(ctx, cmd)
+}
+
+func (a Application) ConfirmPayment(ctx context.Context, cmd commands.ConfirmPayment) (err error) {
+	a.logger.Info().Msg("--> Ordering.ConfirmPayment")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ConfirmPayment") }()
+	return a.App.ConfirmPayment(ctx, cmd)
+}
+
+func (a Application) VoidPayment(ctx context.Context, cmd commands.VoidPayment) (err error) {
+	a.logger.Info().Msg("--> Ordering.VoidPayment")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.VoidPayment") }()
+	return a.App.VoidPayment(ctx, cmd)
+}
+
+func (a Application) GetOrder(ctx context.Context, query queries.GetOrder) (order *domain.OrderView, err error) {
 	a.logger.Info().Msg("--> Ordering.GetOrder")
 	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetOrder") }()
 	return a.App.GetOrder(ctx, query)
 }
+
+func (a Application) GetOrderDetails(ctx context.Context, query queries.GetOrderDetails) (order *domain.Order, err error) {
+	a.logger.Info().Msg("--> Ordering.GetOrderDetails")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetOrderDetails") }()
+	return a.App.GetOrderDetails(ctx, query)
+}
+
+func (a Application) GetAllowedTransitions(ctx context.Context, query queries.GetAllowedTransitions) (statuses []domain.OrderStatus, err error) {
+	a.logger.Info().Msg("--> Ordering.GetAllowedTransitions")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetAllowedTransitions") }()
+	return a.App.GetAllowedTransitions(ctx, query)
+}
+
+func (a Application) ListReturns(ctx context.Context, query queries.ListReturns) (returns []domain.Return, err error) {
+	a.logger.Info().Msg("--> Ordering.ListReturns")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ListReturns") }()
+	return a.App.ListReturns(ctx, query)
+}
+
+func (a Application) ListHeldOrders(ctx context.Context, query queries.ListHeldOrders) (orders []domain.HeldOrder, err error) {
+	a.logger.Info().Msg("--> Ordering.ListHeldOrders")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ListHeldOrders") }()
+	return a.App.ListHeldOrders(ctx, query)
+}
+
+func (a Application) GetOrderSaga(ctx context.Context, query queries.GetOrderSaga) (saga *domain.OrderSaga, err error) {
+	a.logger.Info().Msg("--> Ordering.GetOrderSaga")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetOrderSaga") }()
+	return a.App.GetOrderSaga(ctx, query)
+}
+
+func (a Application) ListOrders(ctx context.Context, query queries.ListOrders) (page *domain.OrderViewPage, err error) {
+	a.logger.Info().Msg("--> Ordering.ListOrders")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.ListOrders") }()
+	return a.App.ListOrders(ctx, query)
+}
+
+func (a Application) SearchOrders(ctx context.Context, query queries.SearchOrders) (page *domain.OrderViewPage, err error) {
+	a.logger.Info().Msg("--> Ordering.SearchOrders")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.SearchOrders") }()
+	return a.App.SearchOrders(ctx, query)
+}
+
+func (a Application) GetOrderHistory(ctx context.Context, query queries.GetOrderHistory) (events []domain.RecordedEvent, err error) {
+	a.logger.Info().Msg("--> Ordering.GetOrderHistory")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetOrderHistory") }()
+	return a.App.GetOrderHistory(ctx, query)
+}
+
+func (a Application) GetOrderAsOf(ctx context.Context, query queries.GetOrderAsOf) (order *domain.Order, err error) {
+	a.logger.Info().Msg("--> Ordering.GetOrderAsOf")
+	defer func() { a.logger.Info().Err(err).Msg("<-- Ordering.GetOrderAsOf") }()
+	return a.App.GetOrderAsOf(ctx, query)
+}
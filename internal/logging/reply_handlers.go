@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/v8tix/eda/ddd"
+)
+
+type ReplyHandlers[T ddd.Reply] struct {
+	ddd.ReplyHandler[T]
+	label  string
+	logger zerolog.Logger
+}
+
+var _ ddd.ReplyHandler[ddd.Reply] = (*ReplyHandlers[ddd.Reply])(nil)
+
+func LogReplyHandlerAccess[T ddd.Reply](handlers ddd.ReplyHandler[T], label string, logger zerolog.Logger) ddd.ReplyHandler[T] {
+	return ReplyHandlers[T]{
+		ReplyHandler: handlers,
+		label:        label,
+		logger:       logger,
+	}
+}
+
+func (h ReplyHandlers[T]) HandleReply(ctx context.Context, reply T) (err error) {
+	h.logger.Info().Msgf("--> Ordering.%s.On(%s)", h.label, reply.ReplyName())
+	defer func() { h.logger.Info().Err(err).Msgf("<-- Ordering.%s.On(%s)", h.label, reply.ReplyName()) }()
+	return h.ReplyHandler.HandleReply(ctx, reply)
+}
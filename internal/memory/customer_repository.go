@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// CustomerRepository stands in for the customers service in local environments;
+// every customer is authorized except the blocked ones.
+type CustomerRepository struct {
+	blocked map[string]struct{}
+}
+
+var _ domain.CustomerRepository = (*CustomerRepository)(nil)
+
+func NewCustomerRepository(blockedCustomerIDs []string) CustomerRepository {
+	blocked := make(map[string]struct{}, len(blockedCustomerIDs))
+	for _, id := range blockedCustomerIDs {
+		blocked[id] = struct{}{}
+	}
+	return CustomerRepository{blocked: blocked}
+}
+
+func (r CustomerRepository) Authorize(_ context.Context, customerID string) error {
+	if _, exists := r.blocked[customerID]; exists {
+		return domain.ErrCustomerNotAuthorized
+	}
+	return nil
+}
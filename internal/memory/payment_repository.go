@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// PaymentRepository stands in for the payments service in local environments;
+// every payment is confirmed except the declined ones.
+type PaymentRepository struct {
+	declined map[string]struct{}
+}
+
+var _ domain.PaymentRepository = (*PaymentRepository)(nil)
+
+func NewPaymentRepository(declinedPaymentIDs []string) PaymentRepository {
+	declined := make(map[string]struct{}, len(declinedPaymentIDs))
+	for _, id := range declinedPaymentIDs {
+		declined[id] = struct{}{}
+	}
+	return PaymentRepository{declined: declined}
+}
+
+func (r PaymentRepository) Confirm(_ context.Context, paymentID string) error {
+	if _, exists := r.declined[paymentID]; exists {
+		return domain.ErrPaymentNotConfirmed
+	}
+	return nil
+}
+
+func (r PaymentRepository) Void(_ context.Context, _ string) error {
+	return nil
+}
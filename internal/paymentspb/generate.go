@@ -0,0 +1,5 @@
+// Package paymentspb is the client of the payments service. No module publishes
+// its proto for this one to depend on, so the proto is kept here.
+package paymentspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative paymentspb/payments.api.proto
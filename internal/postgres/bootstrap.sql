@@ -0,0 +1,6 @@
+-- Statements that need a superuser, run once per database by a DBA before
+-- schema.sql. The ordering service itself does not need them to work.
+
+-- pg_trgm lets the orders view index its search text for SearchOrders; without it
+-- the search still works but scans the whole view.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
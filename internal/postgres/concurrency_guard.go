@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// ConcurrencyGuard refuses to append to an event stream that has moved on since
+// the aggregate was loaded. The stream stays locked until the transaction ends,
+// so two writers cannot both pass the check.
+type ConcurrencyGuard struct {
+	es.AggregateStore
+	tableName string
+	db        pg.DB
+}
+
+var _ es.AggregateStore = (*ConcurrencyGuard)(nil)
+
+func NewConcurrencyGuard(tableName string, db pg.DB) es.AggregateStoreMiddleware {
+	guard := ConcurrencyGuard{
+		tableName: tableName,
+		db:        db,
+	}
+
+	return func(store es.AggregateStore) es.AggregateStore {
+		guard.AggregateStore = store
+		return guard
+	}
+}
+
+func (g ConcurrencyGuard) Save(ctx context.Context, aggregate es.EventSourcedAggregate) error {
+	const lockQuery = `SELECT pg_advisory_xact_lock(hashtext($1 || ':' || $2))`
+	const versionQuery = `SELECT COALESCE(MAX(stream_version), 0) FROM %s WHERE stream_id = $1 AND stream_name = $2`
+
+	if _, err := g.db.ExecContext(ctx, lockQuery, aggregate.AggregateName(), aggregate.ID()); err != nil {
+		return errors.Wrap(err, "locking event stream")
+	}
+
+	var version int
+	if err := g.db.QueryRowContext(ctx, g.table(versionQuery), aggregate.ID(), aggregate.AggregateName()).Scan(&version); err != nil {
+		return errors.Wrap(err, "reading event stream version")
+	}
+
+	if version != aggregate.Version() {
+		return errors.Wrap(domain.ErrConcurrencyConflict,
+			fmt.Sprintf("the stream is at version %d, not %d", version, aggregate.Version()),
+		)
+	}
+
+	err := g.AggregateStore.Save(ctx, aggregate)
+	if err != nil {
+		// writers that bypass the lock still trip over the stream version key
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return errors.Wrap(domain.ErrConcurrencyConflict, pgErr.Message)
+		}
+	}
+
+	return err
+}
+
+func (g ConcurrencyGuard) table(query string) string {
+	return fmt.Sprintf(query, g.tableName)
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type DeadlineRepository struct {
+	tableName string
+	db        pg.DB
+}
+
+var _ domain.DeadlineRepository = (*DeadlineRepository)(nil)
+
+func NewDeadlineRepository(tableName string, db pg.DB) DeadlineRepository {
+	return DeadlineRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r DeadlineRepository) Schedule(ctx context.Context, orderID string, expiresAt time.Time) error {
+	const query = `INSERT INTO %s (order_id, expires_at) VALUES ($1, $2) 
+ON CONFLICT (order_id) DO UPDATE SET expires_at = EXCLUDED.expires_at`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), orderID, expiresAt)
+
+	return err
+}
+
+func (r DeadlineRepository) Remove(ctx context.Context, orderID string) error {
+	const query = `DELETE FROM %s WHERE order_id = $1`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), orderID)
+
+	return err
+}
+
+// FindDue skips deadlines locked by other instances rather than waiting on them.
+func (r DeadlineRepository) FindDue(ctx context.Context, now time.Time, limit int) (orderIDs []string, err error) {
+	const query = `SELECT order_id FROM %s WHERE expires_at <= $1 ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying due deadlines")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing deadline rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var orderID string
+		if err = rows.Scan(&orderID); err != nil {
+			return nil, errors.Wrap(err, "scanning deadline")
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	return orderIDs, rows.Err()
+}
+
+func (r DeadlineRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type HeldOrderRepository struct {
+	tableName string
+	db        pg.DB
+}
+
+var _ domain.HeldOrderRepository = (*HeldOrderRepository)(nil)
+
+func NewHeldOrderRepository(tableName string, db pg.DB) HeldOrderRepository {
+	return HeldOrderRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r HeldOrderRepository) Add(ctx context.Context, order domain.HeldOrder) error {
+	const query = `INSERT INTO %s (order_id, customer_id, reason, risk_score, total, currency, held_at) 
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT (order_id) DO UPDATE SET reason = EXCLUDED.reason, risk_score = EXCLUDED.risk_score, held_at = EXCLUDED.held_at`
+
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		order.OrderID, order.CustomerID, order.Reason, order.RiskScore, order.Total.Amount, order.Total.Currency, order.HeldAt,
+	)
+
+	return err
+}
+
+func (r HeldOrderRepository) Remove(ctx context.Context, orderID string) error {
+	const query = `DELETE FROM %s WHERE order_id = $1`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), orderID)
+
+	return err
+}
+
+// FindAll returns the longest held orders first.
+func (r HeldOrderRepository) FindAll(ctx context.Context, limit int) (orders []domain.HeldOrder, err error) {
+	const query = `SELECT order_id, customer_id, reason, risk_score, total, currency, held_at FROM %s 
+ORDER BY held_at, order_id LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying held orders")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing held order rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var order domain.HeldOrder
+		err = rows.Scan(&order.OrderID, &order.CustomerID, &order.Reason, &order.RiskScore,
+			&order.Total.Amount, &order.Total.Currency, &order.HeldAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning held order")
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, rows.Err()
+}
+
+func (r HeldOrderRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type IdempotencyKeyRepository struct {
+	tableName string
+	db        pg.DB
+	ttl       time.Duration
+}
+
+var _ domain.IdempotencyKeyRepository = (*IdempotencyKeyRepository)(nil)
+
+func NewIdempotencyKeyRepository(tableName string, db pg.DB, ttl time.Duration) IdempotencyKeyRepository {
+	return IdempotencyKeyRepository{
+		tableName: tableName,
+		db:        db,
+		ttl:       ttl,
+	}
+}
+
+// Claim takes over expired keys. A request claiming a key that another
+// transaction has just claimed waits for that transaction, and then sees its claim.
+func (r IdempotencyKeyRepository) Claim(ctx context.Context, key, requestHash, orderID string) (domain.IdempotencyKey, error) {
+	const query = `INSERT INTO %s AS k (idempotency_key, request_hash, order_id, expires_at) VALUES ($1, $2, $3, $4) 
+ON CONFLICT (idempotency_key) DO UPDATE 
+SET request_hash = EXCLUDED.request_hash, order_id = EXCLUDED.order_id, expires_at = EXCLUDED.expires_at 
+WHERE k.expires_at <= CURRENT_TIMESTAMP`
+	const existingQuery = `SELECT request_hash, order_id, expires_at FROM %s WHERE idempotency_key = $1`
+
+	claim := domain.IdempotencyKey{
+		Key:         key,
+		RequestHash: requestHash,
+		OrderID:     orderID,
+		ExpiresAt:   time.Now().Add(r.ttl),
+	}
+
+	result, err := r.db.ExecContext(ctx, r.table(query), claim.Key, claim.RequestHash, claim.OrderID, claim.ExpiresAt)
+	if err != nil {
+		return domain.IdempotencyKey{}, err
+	}
+
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		return domain.IdempotencyKey{}, err
+	}
+
+	if claimed == 1 {
+		return claim, nil
+	}
+
+	existing := domain.IdempotencyKey{Key: key}
+	err = r.db.QueryRowContext(ctx, r.table(existingQuery), key).Scan(&existing.RequestHash, &existing.OrderID, &existing.ExpiresAt)
+	if err != nil {
+		return domain.IdempotencyKey{}, err
+	}
+
+	return existing, nil
+}
+
+// DeleteExpired leaves keys alone that a concurrent Claim has just taken over, as
+// their new expiry is in the future.
+func (r IdempotencyKeyRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int, error) {
+	const query = `DELETE FROM %[1]s WHERE idempotency_key IN (
+SELECT idempotency_key FROM %[1]s WHERE expires_at <= $1 ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED
+) AND expires_at <= $1`
+
+	result, err := r.db.ExecContext(ctx, r.table(query), before, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "deleting expired idempotency keys")
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+func (r IdempotencyKeyRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
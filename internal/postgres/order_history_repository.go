@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// OrderHistoryRepository reads the order streams of the event store; its registry
+// must be the one the event store loads events with.
+type OrderHistoryRepository struct {
+	tableName string
+	db        pg.DB
+	registry  registry.Registry
+}
+
+var _ domain.OrderHistoryRepository = (*OrderHistoryRepository)(nil)
+
+func NewOrderHistoryRepository(tableName string, db pg.DB, registry registry.Registry) OrderHistoryRepository {
+	return OrderHistoryRepository{
+		tableName: tableName,
+		db:        db,
+		registry:  registry,
+	}
+}
+
+func (r OrderHistoryRepository) History(ctx context.Context, orderID string) (events []domain.RecordedEvent, err error) {
+	const query = `SELECT event_id, event_name, stream_version, occurred_at, event_data FROM %s
+WHERE stream_id = $1 AND stream_name = $2 ORDER BY stream_version`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), orderID, domain.OrderAggregate)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying order events")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing order event rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var event domain.RecordedEvent
+		err = rows.Scan(&event.ID, &event.Name, &event.Version, &event.OccurredAt, &event.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning order event")
+		}
+		if event.Payload, err = r.registry.Deserialize(event.Name, event.Data); err != nil {
+			return nil, errors.Wrapf(err, "decoding order event %s", event.ID)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r OrderHistoryRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// OrderRepository adds point in time loads to the aggregate repository of the
+// orders. Snapshots are read with registry and events with the eventRegistry the
+// event store uses.
+type OrderRepository struct {
+	es.AggregateRepository[*domain.Order]
+	eventsTable    string
+	snapshotsTable string
+	db             pg.DB
+	registry       registry.Registry
+	eventRegistry  registry.Registry
+}
+
+var _ domain.OrderRepository = (*OrderRepository)(nil)
+
+func NewOrderRepository(orders es.AggregateRepository[*domain.Order], eventsTable, snapshotsTable string, db pg.DB,
+	registry, eventRegistry registry.Registry,
+) OrderRepository {
+	return OrderRepository{
+		AggregateRepository: orders,
+		eventsTable:         eventsTable,
+		snapshotsTable:      snapshotsTable,
+		db:                  db,
+		registry:            registry,
+		eventRegistry:       eventRegistry,
+	}
+}
+
+// LoadAsOf starts from the snapshot when it was taken at or before the bound.
+func (r OrderRepository) LoadAsOf(ctx context.Context, orderID string, bound domain.AsOf) (*domain.Order, error) {
+	const snapshotQuery = `SELECT s.stream_version, s.snapshot_name, s.snapshot_data FROM %s s
+JOIN %s e ON e.stream_id = s.stream_id AND e.stream_name = s.stream_name AND e.stream_version = s.stream_version
+WHERE s.stream_id = $1 AND s.stream_name = $2 AND s.stream_version <= $3 AND e.occurred_at <= $4`
+	const eventsQuery = `SELECT stream_version, event_id, event_name, event_data, occurred_at FROM %s
+WHERE stream_id = $1 AND stream_name = $2 AND stream_version > $3 AND stream_version <= $4 AND occurred_at <= $5
+ORDER BY stream_version`
+
+	if bound.IsZero() {
+		return nil, domain.ErrAsOfBoundRequired
+	}
+
+	maxVersion := bound.Version
+	if maxVersion <= 0 {
+		maxVersion = math.MaxInt32
+	}
+	maxTime := bound.Time
+	if maxTime.IsZero() {
+		maxTime = time.Now()
+	}
+
+	order := domain.NewOrder(orderID)
+
+	var snapshotName string
+	var snapshotData []byte
+	var snapshotVersion int
+	err := r.db.QueryRowContext(ctx, fmt.Sprintf(snapshotQuery, r.snapshotsTable, r.eventsTable),
+		orderID, domain.OrderAggregate, maxVersion, maxTime,
+	).Scan(&snapshotVersion, &snapshotName, &snapshotData)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, errors.Wrap(err, "reading order snapshot")
+	default:
+		v, err := r.registry.Deserialize(snapshotName, snapshotData, registry.ValidateImplements((*es.Snapshot)(nil)))
+		if err != nil {
+			return nil, err
+		}
+		if err = es.LoadSnapshot(order, v.(es.Snapshot), snapshotVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(eventsQuery, r.eventsTable),
+		orderID, domain.OrderAggregate, order.Version(), maxVersion, maxTime,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying order events")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing order event rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		event := recordedEvent{aggregate: order}
+		var data []byte
+		err = rows.Scan(&event.version, &event.id, &event.name, &data, &event.occurredAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning order event")
+		}
+		if event.payload, err = r.eventRegistry.Deserialize(event.name, data); err != nil {
+			return nil, errors.Wrapf(err, "decoding order event %s", event.id)
+		}
+		if err = es.LoadEvent(order, event); err != nil {
+			return nil, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if order.Version() == 0 {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+// recordedEvent is an event read back from the event store.
+type recordedEvent struct {
+	id         string
+	name       string
+	payload    ddd.EventPayload
+	occurredAt time.Time
+	aggregate  es.EventSourcedAggregate
+	version    int
+}
+
+var _ ddd.AggregateEvent = (*recordedEvent)(nil)
+
+func (e recordedEvent) ID() string                { return e.id }
+func (e recordedEvent) EventName() string         { return e.name }
+func (e recordedEvent) Payload() ddd.EventPayload { return e.payload }
+func (e recordedEvent) Metadata() ddd.Metadata    { return ddd.Metadata{} }
+func (e recordedEvent) OccurredAt() time.Time     { return e.occurredAt }
+func (e recordedEvent) AggregateName() string     { return e.aggregate.AggregateName() }
+func (e recordedEvent) AggregateID() string       { return e.aggregate.ID() }
+func (e recordedEvent) AggregateVersion() int     { return e.version }
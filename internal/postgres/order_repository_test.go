@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/sqltest"
+)
+
+const testOrderID = "order-1"
+
+var streamStart = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+type storedEvent struct {
+	version    int
+	id         string
+	name       string
+	data       []byte
+	occurredAt time.Time
+}
+
+type storedSnapshot struct {
+	version int
+	name    string
+	data    []byte
+}
+
+// eventStore answers the queries of LoadAsOf from a recorded stream the way the
+// event store tables would.
+type eventStore struct {
+	events   []storedEvent
+	snapshot *storedSnapshot
+	// eventsAfter is the version LoadAsOf asked the events after
+	eventsAfter int64
+}
+
+func (s *eventStore) handle(query string, args []driver.Value) (*sqltest.Rows, error) {
+	maxVersion := int(args[2].(int64))
+
+	switch {
+	case strings.Contains(query, "snapshot_data"):
+		maxTime := args[3].(time.Time)
+		if s.snapshot == nil || s.snapshot.version > maxVersion || s.events[s.snapshot.version-1].occurredAt.After(maxTime) {
+			return &sqltest.Rows{}, nil
+		}
+		return sqltest.Row(int64(s.snapshot.version), s.snapshot.name, s.snapshot.data), nil
+
+	case strings.Contains(query, "event_data"):
+		s.eventsAfter = args[2].(int64)
+		maxVersion = int(args[3].(int64))
+		maxTime := args[4].(time.Time)
+		rows := &sqltest.Rows{Columns: []string{"stream_version", "event_id", "event_name", "event_data", "occurred_at"}}
+		for _, e := range s.events {
+			if e.version > int(s.eventsAfter) && e.version <= maxVersion && !e.occurredAt.After(maxTime) {
+				rows.Values = append(rows.Values, []driver.Value{int64(e.version), e.id, e.name, e.data, e.occurredAt})
+			}
+		}
+		return rows, nil
+	}
+
+	return nil, errors.Wrapf(errors.ErrUnimplemented, "unexpected query %q", query)
+}
+
+func testRegistry(t *testing.T) registry.Registry {
+	t.Helper()
+
+	reg := registry.New()
+	serde := serdes.NewJsonSerde(reg)
+	for _, v := range []registry.Registrable{
+		domain.OrderCreated{},
+		domain.OrderPaymentConfirmed{},
+		domain.OrderApproved{},
+		domain.OrderReadied{},
+		domain.OrderCompleted{},
+	} {
+		if err := serde.Register(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := serde.RegisterKey(domain.OrderV3{}.SnapshotName(), domain.OrderV3{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return reg
+}
+
+// recordStream takes an order from OrderCreated to OrderCompleted, one event a
+// minute, and returns its events the way the event store keeps them along with the
+// snapshot of the order after each of them.
+func recordStream(t *testing.T, reg registry.Registry) ([]storedEvent, []storedSnapshot) {
+	t.Helper()
+
+	order := domain.NewOrder(testOrderID)
+	steps := []func() (ddd.Event, error){
+		func() (ddd.Event, error) {
+			return order.CreateOrder(testOrderID, "customer-1", "payment-1", []domain.Item{{
+				ProductID: "product-1",
+				StoreID:   "store-1",
+				Price:     domain.NewMoney(1250, domain.DefaultCurrency),
+				Quantity:  2,
+			}}, nil, nil, nil, domain.Fulfillment{})
+		},
+		order.ConfirmPayment,
+		func() (ddd.Event, error) { return order.Approve("shopping-1") },
+		order.Ready,
+		func() (ddd.Event, error) { return order.Complete("invoice-1") },
+	}
+
+	var events []storedEvent
+	var snapshots []storedSnapshot
+	for _, step := range steps {
+		if _, err := step(); err != nil {
+			t.Fatal(err)
+		}
+		for _, event := range order.Events() {
+			if err := order.ApplyEvent(event); err != nil {
+				t.Fatal(err)
+			}
+			data, err := reg.Serialize(event.EventName(), event.Payload())
+			if err != nil {
+				t.Fatal(err)
+			}
+			events = append(events, storedEvent{
+				version:    len(events) + 1,
+				id:         event.ID(),
+				name:       event.EventName(),
+				data:       data,
+				occurredAt: streamStart.Add(time.Duration(len(events)) * time.Minute),
+			})
+		}
+		order.CommitEvents()
+
+		snapshot := order.ToSnapshot()
+		data, err := reg.Serialize(snapshot.SnapshotName(), snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		snapshots = append(snapshots, storedSnapshot{version: order.Version(), name: snapshot.SnapshotName(), data: data})
+	}
+
+	return events, snapshots
+}
+
+func TestOrderRepository_LoadAsOf(t *testing.T) {
+	reg := testRegistry(t)
+	events, snapshots := recordStream(t, reg)
+
+	tests := map[string]struct {
+		bound       domain.AsOf
+		snapshot    int
+		wantVersion int
+		wantStatus  domain.OrderStatus
+		wantAfter   int64
+	}{
+		"version bound": {
+			bound:       domain.AsOf{Version: 3},
+			wantVersion: 3,
+			wantStatus:  domain.OrderIsApproved,
+		},
+		"time bound": {
+			bound:       domain.AsOf{Time: events[3].occurredAt},
+			wantVersion: 4,
+			wantStatus:  domain.OrderIsReady,
+		},
+		"time bound between events": {
+			bound:       domain.AsOf{Time: events[1].occurredAt.Add(30 * time.Second)},
+			wantVersion: 2,
+			wantStatus:  domain.OrderIsPending,
+		},
+		"snapshot within the bound": {
+			bound:       domain.AsOf{Version: 5},
+			snapshot:    3,
+			wantVersion: 5,
+			wantStatus:  domain.OrderIsCompleted,
+			wantAfter:   3,
+		},
+		"snapshot newer than the version bound": {
+			bound:       domain.AsOf{Version: 2},
+			snapshot:    5,
+			wantVersion: 2,
+			wantStatus:  domain.OrderIsPending,
+		},
+		"snapshot newer than the time bound": {
+			bound:       domain.AsOf{Time: events[2].occurredAt},
+			snapshot:    4,
+			wantVersion: 3,
+			wantStatus:  domain.OrderIsApproved,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := &eventStore{events: events}
+			if tc.snapshot != 0 {
+				store.snapshot = &snapshots[tc.snapshot-1]
+			}
+			repo := NewOrderRepository(es.AggregateRepository[*domain.Order]{}, "ordering.events", "ordering.snapshots",
+				sqltest.Open(store.handle), reg, reg,
+			)
+
+			order, err := repo.LoadAsOf(context.Background(), testOrderID, tc.bound)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if order.Version() != tc.wantVersion {
+				t.Errorf("version = %d, want %d", order.Version(), tc.wantVersion)
+			}
+			if order.Status != tc.wantStatus {
+				t.Errorf("status = %s, want %s", order.Status, tc.wantStatus)
+			}
+			if store.eventsAfter != tc.wantAfter {
+				t.Errorf("events read after version %d, want %d", store.eventsAfter, tc.wantAfter)
+			}
+			if total := order.GetTotal(); total != domain.NewMoney(2500, domain.DefaultCurrency) {
+				t.Errorf("total = %s, want 25.00 USD", total)
+			}
+		})
+	}
+}
+
+func TestOrderRepository_LoadAsOfBeforeCreation(t *testing.T) {
+	reg := testRegistry(t)
+	events, _ := recordStream(t, reg)
+
+	store := &eventStore{events: events}
+	repo := NewOrderRepository(es.AggregateRepository[*domain.Order]{}, "ordering.events", "ordering.snapshots",
+		sqltest.Open(store.handle), reg, reg,
+	)
+
+	_, err := repo.LoadAsOf(context.Background(), testOrderID, domain.AsOf{Time: streamStart.Add(-time.Minute)})
+	if !errors.Is(err, domain.ErrOrderNotFound) {
+		t.Fatalf("err = %v, want %v", err, domain.ErrOrderNotFound)
+	}
+}
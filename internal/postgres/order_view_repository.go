@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type OrderViewRepository struct {
+	tableName string
+	db        pg.DB
+}
+
+var _ domain.OrderViewRepository = (*OrderViewRepository)(nil)
+
+func NewOrderViewRepository(tableName string, db pg.DB) OrderViewRepository {
+	return OrderViewRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r OrderViewRepository) Save(ctx context.Context, view domain.OrderView) error {
+	const query = `INSERT INTO %s AS v (order_id, customer_id, payment_id, status, items, search_text, item_count,
+subtotal, discount_total, tax_total, total, currency, created_at, updated_at, version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (order_id) DO UPDATE
+SET customer_id = EXCLUDED.customer_id, payment_id = EXCLUDED.payment_id, status = EXCLUDED.status,
+items = EXCLUDED.items, search_text = EXCLUDED.search_text, item_count = EXCLUDED.item_count, subtotal = EXCLUDED.subtotal,
+discount_total = EXCLUDED.discount_total, tax_total = EXCLUDED.tax_total, total = EXCLUDED.total,
+currency = EXCLUDED.currency, created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at, version = EXCLUDED.version
+WHERE v.version <= EXCLUDED.version`
+
+	items, err := json.Marshal(view.Items)
+	if err != nil {
+		return errors.Wrap(err, "encoding order view items")
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query),
+		view.OrderID, view.CustomerID, view.PaymentID, view.Status.String(), items, searchText(view.Items), view.ItemCount,
+		view.Subtotal.Amount, view.DiscountTotal.Amount, view.TaxTotal.Amount, view.Total.Amount, view.Total.Currency,
+		view.CreatedAt, view.UpdatedAt, view.Version,
+	)
+
+	return err
+}
+
+func (r OrderViewRepository) Find(ctx context.Context, orderID string) (*domain.OrderView, error) {
+	const query = `SELECT ` + orderViewColumns + ` FROM %s WHERE order_id = $1`
+
+	view, err := scanOrderView(r.db.QueryRowContext(ctx, r.table(query), orderID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, errors.Wrap(err, "scanning order view")
+	}
+
+	return view, nil
+}
+
+func (r OrderViewRepository) FindAll(ctx context.Context, filter domain.OrderViewFilter, after *domain.OrderViewCursor, limit int) ([]domain.OrderView, error) {
+	var where conditions
+
+	if filter.CustomerID != "" {
+		where.add("customer_id = $%d", filter.CustomerID)
+	}
+	if len(filter.Statuses) != 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = status.String()
+		}
+		where.add("status = ANY($%d)", statuses)
+	}
+	if filter.StoreID != "" {
+		storeItem, err := json.Marshal([]map[string]string{{"StoreID": filter.StoreID}})
+		if err != nil {
+			return nil, errors.Wrap(err, "encoding store filter")
+		}
+		where.add("items @> $%d", storeItem)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		where.add("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		where.add("created_at < $%d", filter.CreatedTo)
+	}
+
+	return r.findPage(ctx, where, after, limit)
+}
+
+// Search matches orders holding items whose product or store names contain every
+// word of the text.
+func (r OrderViewRepository) Search(ctx context.Context, text string, after *domain.OrderViewCursor, limit int) ([]domain.OrderView, error) {
+	var where conditions
+
+	for _, word := range strings.Fields(text) {
+		where.add(`search_text ILIKE $%d ESCAPE '\'`, "%"+likeEscaper.Replace(word)+"%")
+	}
+
+	return r.findPage(ctx, where, after, limit)
+}
+
+func (r OrderViewRepository) findPage(ctx context.Context, where conditions, after *domain.OrderViewCursor, limit int) (views []domain.OrderView, err error) {
+	const query = `SELECT ` + orderViewColumns + ` FROM %s %s ORDER BY created_at DESC, order_id DESC LIMIT %d`
+
+	if after != nil {
+		where.add("(created_at, order_id) < ($%d, $%d)", after.CreatedAt, after.OrderID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, r.tableName, where.String(), limit), where.args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying order views")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing order view rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		view, err := scanOrderView(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning order view")
+		}
+		views = append(views, *view)
+	}
+
+	return views, rows.Err()
+}
+
+func (r OrderViewRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
+
+func scanOrderView(row interface{ Scan(dest ...any) error }) (*domain.OrderView, error) {
+	var view domain.OrderView
+	var status, currency string
+	var items []byte
+
+	err := row.Scan(&view.OrderID, &view.CustomerID, &view.PaymentID, &status, &items, &view.ItemCount,
+		&view.Subtotal.Amount, &view.DiscountTotal.Amount, &view.TaxTotal.Amount, &view.Total.Amount, &currency,
+		&view.CreatedAt, &view.UpdatedAt, &view.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(items, &view.Items); err != nil {
+		return nil, errors.Wrap(err, "decoding order view items")
+	}
+	view.Status = domain.ToOrderStatus(status)
+	view.Subtotal.Currency = currency
+	view.DiscountTotal.Currency = currency
+	view.TaxTotal.Currency = currency
+	view.Total.Currency = currency
+
+	return &view, nil
+}
+
+const orderViewColumns = `order_id, customer_id, payment_id, status, items, item_count,
+subtotal, discount_total, tax_total, total, currency, created_at, updated_at, version`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// conditions builds the WHERE clause of a query; each condition formats the
+// placeholders of its own arguments.
+type conditions struct {
+	clauses []string
+	args    []any
+}
+
+func (c *conditions) add(clause string, args ...any) {
+	placeholders := make([]any, len(args))
+	for i := range args {
+		placeholders[i] = len(c.args) + i + 1
+	}
+	c.clauses = append(c.clauses, fmt.Sprintf(clause, placeholders...))
+	c.args = append(c.args, args...)
+}
+
+func (c conditions) String() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(c.clauses, " AND ")
+}
+
+func searchText(items []domain.Item) string {
+	names := make([]string, 0, len(items)*2)
+	for _, item := range items {
+		names = append(names, item.ProductName, item.StoreName)
+	}
+	return strings.Join(names, " ")
+}
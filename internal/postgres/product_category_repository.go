@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgtype"
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type ProductCategoryRepository struct {
+	tableName string
+	db        pg.DB
+}
+
+var _ domain.ProductCategoryRepository = (*ProductCategoryRepository)(nil)
+
+func NewProductCategoryRepository(tableName string, db pg.DB) ProductCategoryRepository {
+	return ProductCategoryRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r ProductCategoryRepository) Find(ctx context.Context, productIDs []string) (categories map[string]string, err error) {
+	const query = `SELECT product_id, category FROM %s WHERE product_id = ANY ($1)`
+
+	ids := &pgtype.TextArray{}
+	if err = ids.Set(productIDs); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying product categories")
+	}
+	defer func(rows *sql.Rows) {
+		if cErr := rows.Close(); cErr != nil && err == nil {
+			err = errors.Wrap(cErr, "closing product category rows")
+		}
+	}(rows)
+
+	categories = make(map[string]string, len(productIDs))
+	for rows.Next() {
+		var productID, category string
+		if err = rows.Scan(&productID, &category); err != nil {
+			return nil, errors.Wrap(err, "scanning product category")
+		}
+		categories[productID] = category
+	}
+
+	return categories, rows.Err()
+}
+
+func (r ProductCategoryRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	percentageOffStore = "percentage_off_store"
+	buyXGetY           = "buy_x_get_y"
+	fixedCoupon        = "fixed_coupon"
+)
+
+type PromotionRepository struct {
+	tableName string
+	db        pg.DB
+}
+
+var _ domain.PromotionRepository = (*PromotionRepository)(nil)
+
+func NewPromotionRepository(tableName string, db pg.DB) PromotionRepository {
+	return PromotionRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r PromotionRepository) FindActive(ctx context.Context) (rules []domain.PromotionRule, err error) {
+	const query = `SELECT id, kind, store_id, product_id, coupon_code, basis_points, buy_quantity, get_quantity, amount, currency 
+FROM %s 
+WHERE starts_at <= CURRENT_TIMESTAMP AND (ends_at IS NULL OR ends_at > CURRENT_TIMESTAMP) 
+ORDER BY priority ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying promotions")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing promotion rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var id, kind, storeID, productID, couponCode, currency string
+		var basisPoints, amount int64
+		var buy, get int
+
+		if err = rows.Scan(&id, &kind, &storeID, &productID, &couponCode, &basisPoints, &buy, &get, &amount, &currency); err != nil {
+			return nil, errors.Wrap(err, "scanning promotion")
+		}
+
+		switch kind {
+		case percentageOffStore:
+			rules = append(rules, domain.PercentageOffStore{ID: id, StoreID: storeID, BasisPoints: basisPoints})
+		case buyXGetY:
+			rules = append(rules, domain.BuyXGetY{ID: id, ProductID: productID, Buy: buy, Get: get})
+		case fixedCoupon:
+			rules = append(rules, domain.FixedCoupon{ID: id, Code: couponCode, Amount: domain.NewMoney(amount, currency)})
+		default:
+			return nil, errors.ErrInternal.Msgf("promotion %s has an unknown kind %q", id, kind)
+		}
+	}
+
+	return rules, rows.Err()
+}
+
+func (r PromotionRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// RiskScorer applies the risk rules to the order activity it keeps itself; every
+// order scored counts towards the velocity of the customer's later orders.
+type RiskScorer struct {
+	tableName string
+	db        pg.DB
+	rules     domain.RiskRules
+}
+
+var _ domain.RiskScorer = (*RiskScorer)(nil)
+
+func NewRiskScorer(tableName string, db pg.DB, rules domain.RiskRules) RiskScorer {
+	return RiskScorer{
+		tableName: tableName,
+		db:        db,
+		rules:     rules,
+	}
+}
+
+func (s RiskScorer) Score(ctx context.Context, order *domain.Order) (domain.RiskAssessment, error) {
+	const countQuery = `SELECT count(*), count(*) FILTER (WHERE created_at > $2) FROM %s WHERE customer_id = $1 AND order_id <> $3`
+	const paymentQuery = `SELECT EXISTS (SELECT 1 FROM %s WHERE customer_id = $1 AND payment_id = $2 AND order_id <> $3)`
+	const recordQuery = `INSERT INTO %s (order_id, customer_id, payment_id, total, currency) VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT (order_id) DO NOTHING`
+
+	var orders, recentOrders int
+	since := time.Now().Add(-s.rules.VelocityWindow)
+	err := s.db.QueryRowContext(ctx, s.table(countQuery), order.CustomerID, since, order.ID()).Scan(&orders, &recentOrders)
+	if err != nil {
+		return domain.RiskAssessment{}, errors.Wrap(err, "counting customer orders")
+	}
+
+	var knownPaymentID bool
+	err = s.db.QueryRowContext(ctx, s.table(paymentQuery), order.CustomerID, order.PaymentID, order.ID()).Scan(&knownPaymentID)
+	if err != nil {
+		return domain.RiskAssessment{}, errors.Wrap(err, "looking up customer payments")
+	}
+
+	total := order.GetTotal()
+	_, err = s.db.ExecContext(ctx, s.table(recordQuery), order.ID(), order.CustomerID, order.PaymentID, total.Amount, total.Currency)
+	if err != nil {
+		return domain.RiskAssessment{}, errors.Wrap(err, "recording order activity")
+	}
+
+	// a first time customer has no payment history to compare against
+	return s.rules.Assess(order, recentOrders, knownPaymentID || orders == 0), nil
+}
+
+func (s RiskScorer) table(query string) string {
+	return fmt.Sprintf(query, s.tableName)
+}
@@ -0,0 +1,204 @@
+-- Tables owned by the ordering service in addition to the event store tables
+-- (ordering.events, ordering.snapshots, ordering.inbox and ordering.outbox).
+
+CREATE TABLE ordering.promotions
+(
+  id           text        NOT NULL,
+  kind         text        NOT NULL, -- percentage_off_store, buy_x_get_y or fixed_coupon
+  store_id     text        NOT NULL DEFAULT '',
+  product_id   text        NOT NULL DEFAULT '',
+  coupon_code  text        NOT NULL DEFAULT '',
+  basis_points bigint      NOT NULL DEFAULT 0,
+  buy_quantity int         NOT NULL DEFAULT 0,
+  get_quantity int         NOT NULL DEFAULT 0,
+  amount       bigint      NOT NULL DEFAULT 0,
+  currency     text        NOT NULL DEFAULT 'USD',
+  priority     int         NOT NULL DEFAULT 0,
+  starts_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  ends_at      timestamptz,
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE ordering.tax_rates
+(
+  store_id       text        NOT NULL,
+  category       text        NOT NULL DEFAULT '', -- blank applies to every category of the store
+  basis_points   bigint      NOT NULL,             -- 825 = 8.25%
+  effective_from timestamptz NOT NULL,
+  effective_to   timestamptz,
+  PRIMARY KEY (store_id, category, effective_from)
+);
+
+CREATE TABLE ordering.product_categories
+(
+  product_id text NOT NULL,
+  category   text NOT NULL, -- matches ordering.tax_rates.category
+  PRIMARY KEY (product_id)
+);
+
+CREATE TABLE ordering.fulfillment_slots
+(
+  method      text        NOT NULL, -- pickup-counter, locker or bot-delivery
+  location_id text        NOT NULL, -- counter, locker bank or delivery zone
+  starts_at   timestamptz NOT NULL,
+  ends_at     timestamptz NOT NULL,
+  capacity    int         NOT NULL,
+  PRIMARY KEY (method, location_id, starts_at)
+);
+
+CREATE TABLE ordering.slot_reservations
+(
+  order_id    text        NOT NULL,
+  method      text        NOT NULL,
+  location_id text        NOT NULL,
+  starts_at   timestamptz NOT NULL,
+  ends_at     timestamptz NOT NULL,
+  reserved_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (order_id)
+);
+
+CREATE INDEX slot_reservations_slot_idx ON ordering.slot_reservations (method, location_id, starts_at);
+
+CREATE TABLE ordering.deadlines
+(
+  order_id   text        NOT NULL,
+  expires_at timestamptz NOT NULL,
+  PRIMARY KEY (order_id)
+);
+
+CREATE INDEX deadlines_expires_at_idx ON ordering.deadlines (expires_at);
+
+CREATE TABLE ordering.risk_activity
+(
+  order_id    text        NOT NULL,
+  customer_id text        NOT NULL,
+  payment_id  text        NOT NULL,
+  total       bigint      NOT NULL,
+  currency    text        NOT NULL,
+  created_at  timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (order_id)
+);
+
+CREATE INDEX risk_activity_customer_idx ON ordering.risk_activity (customer_id, created_at);
+
+CREATE TABLE ordering.held_orders
+(
+  order_id    text        NOT NULL,
+  customer_id text        NOT NULL,
+  reason      text        NOT NULL,
+  risk_score  int         NOT NULL DEFAULT 0,
+  total       bigint      NOT NULL,
+  currency    text        NOT NULL,
+  held_at     timestamptz NOT NULL,
+  PRIMARY KEY (order_id)
+);
+
+CREATE TABLE ordering.sagas
+(
+  name         text        NOT NULL,
+  id           text        NOT NULL,
+  data         bytea       NOT NULL,
+  step         int         NOT NULL,
+  done         bool        NOT NULL,
+  compensating bool        NOT NULL,
+  updated_at   timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (name, id)
+);
+
+CREATE TABLE ordering.idempotency_keys
+(
+  idempotency_key text        NOT NULL,
+  request_hash    text        NOT NULL,
+  order_id        text        NOT NULL,
+  expires_at      timestamptz NOT NULL,
+  created_at      timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (idempotency_key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON ordering.idempotency_keys (expires_at);
+
+-- the snapshot store records when each snapshot was taken for its time based strategy
+ALTER TABLE ordering.snapshots ADD COLUMN IF NOT EXISTS taken_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- version is the stream version of the last event projected into the row
+CREATE TABLE ordering.orders_view
+(
+  order_id       text        NOT NULL,
+  customer_id    text        NOT NULL,
+  payment_id     text        NOT NULL,
+  status         text        NOT NULL,
+  items          jsonb       NOT NULL,
+  search_text    text        NOT NULL, -- the product and store names of the items
+  item_count     int         NOT NULL,
+  subtotal       bigint      NOT NULL,
+  discount_total bigint      NOT NULL,
+  tax_total      bigint      NOT NULL,
+  total          bigint      NOT NULL,
+  currency       text        NOT NULL,
+  created_at     timestamptz NOT NULL,
+  updated_at     timestamptz NOT NULL,
+  version        int         NOT NULL,
+  PRIMARY KEY (order_id)
+);
+
+CREATE INDEX orders_view_customer_id_idx ON ordering.orders_view (customer_id, created_at);
+CREATE INDEX orders_view_created_at_idx ON ordering.orders_view (created_at, order_id);
+CREATE INDEX orders_view_items_idx ON ordering.orders_view USING gin (items jsonb_path_ops);
+
+-- the search text index needs pg_trgm, which bootstrap.sql installs; without it
+-- SearchOrders scans the view
+DO
+$$
+  BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm') THEN
+      CREATE INDEX IF NOT EXISTS orders_view_search_text_idx ON ordering.orders_view USING gin (search_text gin_trgm_ops);
+    END IF;
+  END
+$$;
+
+-- the replay command reads the event store in the order the events were appended.
+-- A position is only drawn once the inserting transaction has an id, so that the
+-- replay can tell which positions may still be taken by transactions in flight.
+CREATE SEQUENCE IF NOT EXISTS ordering.events_global_position_seq;
+
+CREATE OR REPLACE FUNCTION ordering.next_global_position() RETURNS bigint
+  LANGUAGE sql
+  VOLATILE
+AS
+$$
+SELECT CASE WHEN pg_current_xact_id() IS NOT NULL THEN nextval('ordering.events_global_position_seq') END
+$$;
+
+LOCK TABLE ordering.events IN SHARE ROW EXCLUSIVE MODE;
+
+ALTER TABLE ordering.events ADD COLUMN IF NOT EXISTS global_position bigint;
+
+-- rows that existed before the column was added are numbered in the order they occurred
+UPDATE ordering.events e
+SET global_position = n.position
+FROM (SELECT stream_id, stream_name, stream_version,
+             row_number() OVER (ORDER BY occurred_at, stream_id, stream_version) +
+             (SELECT COALESCE(MAX(global_position), 0) FROM ordering.events) AS position
+      FROM ordering.events
+      WHERE global_position IS NULL) n
+WHERE e.stream_id = n.stream_id
+  AND e.stream_name = n.stream_name
+  AND e.stream_version = n.stream_version;
+
+SELECT setval('ordering.events_global_position_seq', COALESCE(MAX(global_position), 0) + 1, false)
+FROM ordering.events;
+
+ALTER SEQUENCE ordering.events_global_position_seq OWNED BY ordering.events.global_position;
+ALTER TABLE ordering.events
+  ALTER COLUMN global_position SET DEFAULT ordering.next_global_position(),
+  ALTER COLUMN global_position SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS events_global_position_idx ON ordering.events (global_position);
+
+CREATE TABLE ordering.projection_checkpoints
+(
+  projection text        NOT NULL,
+  position   bigint      NOT NULL,
+  updated_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (projection)
+);
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type SlotCapacity struct {
+	slotsTable        string
+	reservationsTable string
+	db                pg.DB
+}
+
+var _ domain.SlotCapacity = (*SlotCapacity)(nil)
+
+func NewSlotCapacity(slotsTable, reservationsTable string, db pg.DB) SlotCapacity {
+	return SlotCapacity{
+		slotsTable:        slotsTable,
+		reservationsTable: reservationsTable,
+		db:                db,
+	}
+}
+
+// Reserve locks the slot row so that concurrent bookings for the same slot are
+// counted one after the other.
+func (c SlotCapacity) Reserve(ctx context.Context, orderID string, fulfillment domain.Fulfillment) error {
+	const lockQuery = `SELECT capacity FROM %s WHERE method = $1 AND location_id = $2 AND starts_at = $3 AND ends_at = $4 FOR UPDATE`
+	const countQuery = `SELECT count(*) FROM %s WHERE method = $1 AND location_id = $2 AND starts_at = $3 AND order_id <> $4`
+	const reserveQuery = `INSERT INTO %s (order_id, method, location_id, starts_at, ends_at) VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT (order_id) DO UPDATE SET method = EXCLUDED.method, location_id = EXCLUDED.location_id, starts_at = EXCLUDED.starts_at, ends_at = EXCLUDED.ends_at`
+
+	method := fulfillment.Method.String()
+	slot := fulfillment.Slot
+
+	var capacity int
+	err := c.db.QueryRowContext(ctx, c.slots(lockQuery), method, fulfillment.LocationID, slot.Start, slot.End).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrTimeSlotNotOffered
+		}
+		return errors.Wrap(err, "locking time slot")
+	}
+
+	var booked int
+	err = c.db.QueryRowContext(ctx, c.reservations(countQuery), method, fulfillment.LocationID, slot.Start, orderID).Scan(&booked)
+	if err != nil {
+		return errors.Wrap(err, "counting slot reservations")
+	}
+
+	if booked >= capacity {
+		return domain.ErrTimeSlotIsFullyBooked
+	}
+
+	_, err = c.db.ExecContext(ctx, c.reservations(reserveQuery), orderID, method, fulfillment.LocationID, slot.Start, slot.End)
+	if err != nil {
+		return errors.Wrap(err, "reserving time slot")
+	}
+
+	return nil
+}
+
+func (c SlotCapacity) Release(ctx context.Context, orderID string) error {
+	const query = `DELETE FROM %s WHERE order_id = $1`
+
+	_, err := c.db.ExecContext(ctx, c.reservations(query), orderID)
+
+	return err
+}
+
+func (c SlotCapacity) slots(query string) string {
+	return fmt.Sprintf(query, c.slotsTable)
+}
+
+func (c SlotCapacity) reservations(query string) string {
+	return fmt.Sprintf(query, c.reservationsTable)
+}
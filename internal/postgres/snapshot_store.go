@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/eda/registry"
+)
+
+// LastSnapshot describes the snapshot an aggregate was loaded from; it is zero for
+// aggregates without one.
+type LastSnapshot struct {
+	Version int
+	TakenAt time.Time
+}
+
+// SnapshotStrategy decides, after new events were saved, whether a snapshot of the
+// aggregate is written.
+type SnapshotStrategy interface {
+	ShouldSnapshot(aggregate es.EventSourcedAggregate, last LastSnapshot) bool
+}
+
+// SnapshotStore loads aggregates from their snapshot and writes snapshots whenever
+// its strategy asks for one.
+type SnapshotStore struct {
+	es.AggregateStore
+	tableName string
+	db        pg.DB
+	registry  registry.Registry
+	strategy  SnapshotStrategy
+	loaded    map[string]LastSnapshot
+}
+
+var _ es.AggregateStore = (*SnapshotStore)(nil)
+
+func NewSnapshotStore(tableName string, db pg.DB, registry registry.Registry, strategy SnapshotStrategy) es.AggregateStoreMiddleware {
+	snapshots := SnapshotStore{
+		tableName: tableName,
+		db:        db,
+		registry:  registry,
+		strategy:  strategy,
+		loaded:    make(map[string]LastSnapshot),
+	}
+
+	return func(store es.AggregateStore) es.AggregateStore {
+		snapshots.AggregateStore = store
+		return snapshots
+	}
+}
+
+func (s SnapshotStore) Load(ctx context.Context, aggregate es.EventSourcedAggregate) error {
+	const query = `SELECT stream_version, snapshot_name, snapshot_data, taken_at FROM %s WHERE stream_id = $1 AND stream_name = $2 LIMIT 1`
+
+	var last LastSnapshot
+	var snapshotName string
+	var snapshotData []byte
+
+	err := s.db.QueryRowContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName()).Scan(&last.Version, &snapshotName, &snapshotData, &last.TakenAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			s.loaded[streamKey(aggregate)] = LastSnapshot{}
+			return s.AggregateStore.Load(ctx, aggregate)
+		}
+		return err
+	}
+
+	v, err := s.registry.Deserialize(snapshotName, snapshotData, registry.ValidateImplements((*es.Snapshot)(nil)))
+	if err != nil {
+		return err
+	}
+
+	if err = es.LoadSnapshot(aggregate, v.(es.Snapshot), last.Version); err != nil {
+		return err
+	}
+	s.loaded[streamKey(aggregate)] = last
+
+	return s.AggregateStore.Load(ctx, aggregate)
+}
+
+func (s SnapshotStore) Save(ctx context.Context, aggregate es.EventSourcedAggregate) error {
+	const query = `INSERT INTO %s (stream_id, stream_name, stream_version, snapshot_name, snapshot_data, taken_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (stream_id, stream_name) DO
+UPDATE SET stream_version = EXCLUDED.stream_version, snapshot_name = EXCLUDED.snapshot_name, snapshot_data = EXCLUDED.snapshot_data, taken_at = EXCLUDED.taken_at`
+
+	if err := s.AggregateStore.Save(ctx, aggregate); err != nil {
+		return err
+	}
+
+	// aggregates saved without being loaded first are new and have no snapshot
+	last := s.loaded[streamKey(aggregate)]
+	if len(aggregate.Events()) == 0 || !s.strategy.ShouldSnapshot(aggregate, last) {
+		return nil
+	}
+
+	sser, ok := aggregate.(es.Snapshotter)
+	if !ok {
+		return fmt.Errorf("%T does not implement es.Snapshotter", aggregate)
+	}
+
+	snapshot := sser.ToSnapshot()
+
+	data, err := s.registry.Serialize(snapshot.SnapshotName(), snapshot)
+	if err != nil {
+		return err
+	}
+
+	taken := LastSnapshot{
+		Version: aggregate.PendingVersion(),
+		TakenAt: time.Now(),
+	}
+	_, err = s.db.ExecContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName(), taken.Version, snapshot.SnapshotName(), data, taken.TakenAt)
+	if err != nil {
+		return err
+	}
+	s.loaded[streamKey(aggregate)] = taken
+
+	return nil
+}
+
+func (s SnapshotStore) table(query string) string {
+	return fmt.Sprintf(query, s.tableName)
+}
+
+func streamKey(aggregate es.EventSourcedAggregate) string {
+	return aggregate.AggregateName() + ":" + aggregate.ID()
+}
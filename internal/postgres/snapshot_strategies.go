@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/v8tix/eda/es"
+)
+
+type everyNEvents int
+
+// EveryNEvents snapshots an aggregate once n events were saved since its last snapshot.
+func EveryNEvents(n int) SnapshotStrategy {
+	return everyNEvents(n)
+}
+
+func (n everyNEvents) ShouldSnapshot(aggregate es.EventSourcedAggregate, last LastSnapshot) bool {
+	return aggregate.PendingVersion()-last.Version >= int(n)
+}
+
+type onTerminalStatus struct{}
+
+// OnTerminalStatus snapshots aggregates that reached a status they cannot leave, so
+// that loading a finished aggregate never replays its events again.
+func OnTerminalStatus() SnapshotStrategy {
+	return onTerminalStatus{}
+}
+
+func (onTerminalStatus) ShouldSnapshot(aggregate es.EventSourcedAggregate, _ LastSnapshot) bool {
+	terminal, ok := aggregate.(interface{ IsTerminal() bool })
+	return ok && terminal.IsTerminal()
+}
+
+type olderThan time.Duration
+
+// OlderThan snapshots an aggregate when its last snapshot was taken more than maxAge
+// ago. Aggregates without a snapshot are left to the other strategies.
+func OlderThan(maxAge time.Duration) SnapshotStrategy {
+	return olderThan(maxAge)
+}
+
+func (maxAge olderThan) ShouldSnapshot(_ es.EventSourcedAggregate, last LastSnapshot) bool {
+	return !last.TakenAt.IsZero() && time.Since(last.TakenAt) >= time.Duration(maxAge)
+}
+
+type anyStrategy []SnapshotStrategy
+
+// AnyStrategy snapshots an aggregate when at least one of the strategies asks for it.
+func AnyStrategy(strategies ...SnapshotStrategy) SnapshotStrategy {
+	return anyStrategy(strategies)
+}
+
+func (strategies anyStrategy) ShouldSnapshot(aggregate es.EventSourcedAggregate, last LastSnapshot) bool {
+	for _, strategy := range strategies {
+		if strategy.ShouldSnapshot(aggregate, last) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/stackus/errors"
+
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type TaxCalculator struct {
+	tableName string
+	db        pg.DB
+}
+
+type taxRateKey struct {
+	storeID  string
+	category string
+}
+
+var _ domain.TaxCalculator = (*TaxCalculator)(nil)
+
+func NewTaxCalculator(tableName string, db pg.DB) TaxCalculator {
+	return TaxCalculator{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+// Calculate taxes each line at the rate for its store and product category, falling
+// back to the store wide rate (blank category) and then to no tax at all.
+func (c TaxCalculator) Calculate(ctx context.Context, items []domain.Item, at time.Time) ([]domain.LineTax, error) {
+	rates, err := c.findRates(ctx, items, at)
+	if err != nil {
+		return nil, err
+	}
+
+	taxes := make([]domain.LineTax, len(items))
+	for i, item := range items {
+		basisPoints, exists := rates[taxRateKey{storeID: item.StoreID, category: item.Category}]
+		if !exists {
+			basisPoints = rates[taxRateKey{storeID: item.StoreID}]
+		}
+
+		taxes[i] = domain.LineTax{
+			ProductID:   item.ProductID,
+			StoreID:     item.StoreID,
+			Category:    item.Category,
+			BasisPoints: basisPoints,
+			Amount:      item.Total().Percent(basisPoints),
+		}
+	}
+
+	return taxes, nil
+}
+
+func (c TaxCalculator) findRates(ctx context.Context, items []domain.Item, at time.Time) (rates map[taxRateKey]int64, err error) {
+	const query = `SELECT store_id, category, basis_points FROM %s 
+WHERE store_id = ANY ($1) AND effective_from <= $2 AND (effective_to IS NULL OR effective_to > $2) 
+ORDER BY effective_from ASC`
+
+	storeIDs := make([]string, len(items))
+	for i, item := range items {
+		storeIDs[i] = item.StoreID
+	}
+
+	ids := &pgtype.TextArray{}
+	if err = ids.Set(storeIDs); err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, c.table(query), ids, at)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying tax rates")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing tax rate rows")
+		}
+	}(rows)
+
+	rates = make(map[taxRateKey]int64)
+	for rows.Next() {
+		var key taxRateKey
+		var basisPoints int64
+		if err = rows.Scan(&key.storeID, &key.category, &basisPoints); err != nil {
+			return nil, errors.Wrap(err, "scanning tax rate")
+		}
+		// later effective dates override earlier overlapping rates
+		rates[key] = basisPoints
+	}
+
+	return rates, rows.Err()
+}
+
+func (c TaxCalculator) table(query string) string {
+	return fmt.Sprintf(query, c.tableName)
+}
@@ -0,0 +1,23 @@
+package refundspb
+
+import (
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+)
+
+// OrderRefundedEvent is published on the order aggregate channel of
+// mallbots-ordering-proto alongside the other order events.
+const OrderRefundedEvent = "ordersapi.OrderRefunded"
+
+func Registrations(reg registry.Registry) (err error) {
+	serde := serdes.NewProtoSerde(reg)
+
+	// Order events
+	if err = serde.Register(&OrderRefunded{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*OrderRefunded) Key() string { return OrderRefundedEvent }
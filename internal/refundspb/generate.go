@@ -0,0 +1,6 @@
+// Package refundspb holds the API and integration events for refunding completed
+// orders. They extend the ordering API of mallbots-ordering-proto until that
+// module carries them.
+package refundspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=refundspb/api.annotations.yaml refundspb/refunds.api.proto refundspb/refunds.events.proto
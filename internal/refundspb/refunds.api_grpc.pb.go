@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: refundspb/refunds.api.proto
+
+package refundspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OrderRefundService_RefundOrder_FullMethodName = "/pb.OrderRefundService/RefundOrder"
+)
+
+// OrderRefundServiceClient is the client API for OrderRefundService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderRefundServiceClient interface {
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+}
+
+type orderRefundServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderRefundServiceClient(cc grpc.ClientConnInterface) OrderRefundServiceClient {
+	return &orderRefundServiceClient{cc}
+}
+
+func (c *orderRefundServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, OrderRefundService_RefundOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderRefundServiceServer is the server API for OrderRefundService service.
+// All implementations must embed UnimplementedOrderRefundServiceServer
+// for forward compatibility
+type OrderRefundServiceServer interface {
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	mustEmbedUnimplementedOrderRefundServiceServer()
+}
+
+// UnimplementedOrderRefundServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrderRefundServiceServer struct {
+}
+
+func (UnimplementedOrderRefundServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedOrderRefundServiceServer) mustEmbedUnimplementedOrderRefundServiceServer() {}
+
+// UnsafeOrderRefundServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderRefundServiceServer will
+// result in compilation errors.
+type UnsafeOrderRefundServiceServer interface {
+	mustEmbedUnimplementedOrderRefundServiceServer()
+}
+
+func RegisterOrderRefundServiceServer(s grpc.ServiceRegistrar, srv OrderRefundServiceServer) {
+	s.RegisterService(&OrderRefundService_ServiceDesc, srv)
+}
+
+func _OrderRefundService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderRefundServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderRefundService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderRefundServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderRefundService_ServiceDesc is the grpc.ServiceDesc for OrderRefundService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderRefundService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.OrderRefundService",
+	HandlerType: (*OrderRefundServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrderRefundService_RefundOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "refundspb/refunds.api.proto",
+}
@@ -0,0 +1,31 @@
+package replay
+
+import (
+	"time"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+)
+
+// event is an event read back from the event store with its global position.
+type event struct {
+	position    int64
+	id          string
+	name        string
+	payload     ddd.EventPayload
+	occurredAt  time.Time
+	aggregateID string
+	aggregate   es.EventSourcedAggregate
+	version     int
+}
+
+var _ ddd.AggregateEvent = (*event)(nil)
+
+func (e event) ID() string                { return e.id }
+func (e event) EventName() string         { return e.name }
+func (e event) Payload() ddd.EventPayload { return e.payload }
+func (e event) Metadata() ddd.Metadata    { return ddd.Metadata{} }
+func (e event) OccurredAt() time.Time     { return e.occurredAt }
+func (e event) AggregateName() string     { return e.aggregate.AggregateName() }
+func (e event) AggregateID() string       { return e.aggregateID }
+func (e event) AggregateVersion() int     { return e.version }
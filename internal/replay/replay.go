@@ -0,0 +1,407 @@
+package replay
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	// maxCachedOrders bounds the orders kept between events; an order dropped from
+	// the cache is loaded again from the event store when its next event comes along.
+	maxCachedOrders = 10000
+	// settlePollInterval is how often the replay checks whether the transactions
+	// holding back the settled position have ended.
+	settlePollInterval = 100 * time.Millisecond
+	// unbounded is the settled position once no transaction can append events.
+	unbounded = math.MaxInt64
+)
+
+type (
+	// Projector writes the change an event made to an order into a read model. The
+	// order already has the event applied.
+	Projector interface {
+		Project(ctx context.Context, event ddd.AggregateEvent, order *domain.Order) error
+	}
+
+	// Projection is a read model the replay can rebuild; New returns its projector
+	// writing into the given table.
+	Projection struct {
+		Name  string
+		Table string
+		New   func(db pg.DB, table string) Projector
+	}
+
+	Options struct {
+		BatchSize int
+		// Resume continues from the checkpoint of the last run instead of the first event
+		Resume bool
+		// DryRun reads and applies the events without writing anything
+		DryRun bool
+		// Shadow rebuilds into a copy of the table that replaces it once it caught up
+		Shadow bool
+	}
+
+	// Replayer feeds the events of ordering.events, in the order they were
+	// appended, to projections. Its registry must be the one the event store loads
+	// events with; orders loads the orders missing from its cache.
+	Replayer struct {
+		db               *sql.DB
+		eventsTable      string
+		checkpointsTable string
+		registry         registry.Registry
+		orders           func(tx *sql.Tx) domain.OrderRepository
+		out              io.Writer
+	}
+)
+
+func NewReplayer(db *sql.DB, eventsTable, checkpointsTable string, registry registry.Registry,
+	orders func(tx *sql.Tx) domain.OrderRepository, out io.Writer,
+) Replayer {
+	return Replayer{
+		db:               db,
+		eventsTable:      eventsTable,
+		checkpointsTable: checkpointsTable,
+		registry:         registry,
+		orders:           orders,
+		out:              out,
+	}
+}
+
+// Rebuild replays the events into the projection. Each batch is written in its own
+// transaction together with the checkpoint of the projection.
+//
+// Global positions are drawn before the events are committed, so an event may
+// become visible after events with higher positions. Rebuild only replays events up
+// to the position settled when it started: every transaction that could still
+// commit an event at or below it has ended. The checkpoint never passes an event
+// that is yet to become visible, and a resumed run picks up the events after it.
+//
+// A shadow rebuild ends by locking the events and the table, catching up with the
+// events appended in the meantime and swapping the tables in one transaction;
+// commands appending events or projecting into the table wait for it, and those
+// already waiting on the old table fail.
+func (r Replayer) Rebuild(ctx context.Context, projection Projection, opts Options) (err error) {
+	if opts.BatchSize <= 0 {
+		return errors.Wrap(errors.ErrInvalidArgument, "the batch size must be greater than zero")
+	}
+
+	run := &rebuild{
+		Replayer:   r,
+		projection: projection,
+		opts:       opts,
+		table:      projection.Table,
+		checkpoint: projection.Name,
+		orders:     make(map[string]*domain.Order),
+	}
+	if opts.Shadow {
+		run.table = projection.Table + "_shadow"
+		run.checkpoint = projection.Name + ":shadow"
+	}
+
+	if opts.Resume {
+		if run.position, err = r.readCheckpoint(ctx, run.checkpoint); err != nil {
+			return err
+		}
+	}
+	if opts.Shadow && !opts.DryRun {
+		if err = run.prepareShadow(ctx); err != nil {
+			return err
+		}
+	}
+
+	if run.settled, err = r.settledPosition(ctx); err != nil {
+		return err
+	}
+	if err = run.count(ctx); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(r.out, "%s: replaying %d events after position %d up to %d into %s\n",
+		projection.Name, run.total, run.position, run.settled, run.table,
+	)
+
+	for done := false; !done; {
+		if done, err = run.inTx(ctx, run.batch); err != nil {
+			return err
+		}
+	}
+
+	if opts.Shadow && !opts.DryRun {
+		if _, err = run.inTx(ctx, run.swap); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(r.out, "%s: swapped %s into %s\n", projection.Name, run.table, projection.Table)
+	}
+
+	_, _ = fmt.Fprintf(r.out, "%s: replayed %d events, last position %d\n", projection.Name, run.replayed, run.position)
+
+	return nil
+}
+
+type rebuild struct {
+	Replayer
+	projection Projection
+	opts       Options
+	table      string
+	checkpoint string
+	position   int64
+	settled    int64
+	total      int
+	replayed   int
+	orders     map[string]*domain.Order
+}
+
+func (r *rebuild) inTx(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) (bool, error)) (done bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil || r.opts.DryRun {
+			rErr := tx.Rollback()
+			if err == nil && rErr != nil {
+				err = rErr
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return fn(ctx, tx)
+}
+
+// batch replays the next batch of events and reports whether it was the last one.
+func (r *rebuild) batch(ctx context.Context, tx *sql.Tx) (bool, error) {
+	const query = `SELECT global_position, stream_id, stream_version, event_id, event_name, event_data, occurred_at FROM %s
+WHERE stream_name = $1 AND global_position > $2 AND global_position <= $3 ORDER BY global_position LIMIT $4`
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(query, r.eventsTable), domain.OrderAggregate, r.position, r.settled, r.opts.BatchSize)
+	if err != nil {
+		return false, errors.Wrap(err, "querying events")
+	}
+
+	var events []event
+	for rows.Next() {
+		var e event
+		var data []byte
+		if err = rows.Scan(&e.position, &e.aggregateID, &e.version, &e.id, &e.name, &data, &e.occurredAt); err != nil {
+			_ = rows.Close()
+			return false, errors.Wrap(err, "scanning event")
+		}
+		if e.payload, err = r.registry.Deserialize(e.name, data); err != nil {
+			_ = rows.Close()
+			return false, errors.Wrapf(err, "decoding event %s", e.id)
+		}
+		events = append(events, e)
+	}
+	if err = rows.Close(); err != nil {
+		return false, err
+	}
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	projector := r.projection.New(tx, r.table)
+	for i := range events {
+		e := &events[i]
+		order, err := r.apply(ctx, tx, e)
+		if err != nil {
+			return false, err
+		}
+		if !r.opts.DryRun {
+			if err = projector.Project(ctx, *e, order); err != nil {
+				return false, errors.Wrapf(err, "projecting event %s", e.id)
+			}
+		}
+		r.position = e.position
+		r.replayed++
+	}
+
+	if len(events) != 0 && !r.opts.DryRun {
+		if err = r.writeCheckpoint(ctx, tx, r.checkpoint, r.position); err != nil {
+			return false, err
+		}
+	}
+	_, _ = fmt.Fprintf(r.out, "%s: %d/%d events, position %d\n", r.projection.Name, r.replayed, r.total, r.position)
+
+	return len(events) < r.opts.BatchSize, nil
+}
+
+// apply brings the cached order up to the event.
+func (r *rebuild) apply(ctx context.Context, tx *sql.Tx, e *event) (*domain.Order, error) {
+	order, exists := r.orders[e.aggregateID]
+	if !exists || order.Version() != e.version-1 {
+		if len(r.orders) >= maxCachedOrders {
+			r.orders = make(map[string]*domain.Order)
+		}
+
+		order = domain.NewOrder(e.aggregateID)
+		if e.version > 1 {
+			var err error
+			order, err = r.Replayer.orders(tx).LoadAsOf(ctx, e.aggregateID, domain.AsOf{Version: e.version - 1})
+			if err != nil {
+				return nil, errors.Wrapf(err, "loading order %s at version %d", e.aggregateID, e.version-1)
+			}
+		}
+		r.orders[e.aggregateID] = order
+	}
+
+	e.aggregate = order
+	if err := es.LoadEvent(order, *e); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r *rebuild) count(ctx context.Context) error {
+	const query = `SELECT COUNT(*) FROM %s WHERE stream_name = $1 AND global_position > $2 AND global_position <= $3`
+
+	return r.db.QueryRowContext(ctx, fmt.Sprintf(query, r.eventsTable), domain.OrderAggregate, r.position, r.settled).Scan(&r.total)
+}
+
+// prepareShadow starts a fresh shadow table unless a shadow rebuild is resumed.
+func (r *rebuild) prepareShadow(ctx context.Context) error {
+	const dropQuery = `DROP TABLE IF EXISTS %s`
+	const createQuery = `CREATE TABLE IF NOT EXISTS %s (LIKE %s INCLUDING ALL)`
+
+	if !r.opts.Resume {
+		if _, err := r.db.ExecContext(ctx, fmt.Sprintf(dropQuery, r.table)); err != nil {
+			return errors.Wrap(err, "dropping shadow table")
+		}
+	}
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(createQuery, r.table, r.projection.Table)); err != nil {
+		return errors.Wrap(err, "creating shadow table")
+	}
+
+	return nil
+}
+
+// swap waits for the transactions appending events to end and keeps new ones out,
+// so that the catch-up sees every event there is. The events are locked before the
+// table because commands append their events before they project them.
+func (r *rebuild) swap(ctx context.Context, tx *sql.Tx) (bool, error) {
+	const lockEventsQuery = `LOCK TABLE %s IN SHARE MODE`
+	const lockQuery = `LOCK TABLE %s IN EXCLUSIVE MODE`
+	const renameQuery = `ALTER TABLE %s RENAME TO %s`
+	const dropQuery = `DROP TABLE %s`
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(lockEventsQuery, r.eventsTable)); err != nil {
+		return false, errors.Wrap(err, "locking events")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(lockQuery, r.projection.Table)); err != nil {
+		return false, errors.Wrap(err, "locking table")
+	}
+	r.settled = unbounded
+
+	for done := false; !done; {
+		var err error
+		if done, err = r.batch(ctx, tx); err != nil {
+			return false, err
+		}
+	}
+
+	old := r.projection.Table + "_old"
+	for _, query := range []string{
+		fmt.Sprintf(renameQuery, r.projection.Table, unqualified(old)),
+		fmt.Sprintf(renameQuery, r.table, unqualified(r.projection.Table)),
+		fmt.Sprintf(dropQuery, old),
+	} {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return false, errors.Wrap(err, "swapping tables")
+		}
+	}
+
+	if err := r.writeCheckpoint(ctx, tx, r.projection.Name, r.position); err != nil {
+		return false, err
+	}
+
+	return true, r.deleteCheckpoint(ctx, tx, r.checkpoint)
+}
+
+// settledPosition returns a position no event can be committed at or below anymore.
+// Positions are only drawn by transactions that already have an id, so once every
+// transaction older than the last position drawn has ended, the position settled.
+func (r Replayer) settledPosition(ctx context.Context) (int64, error) {
+	const positionQuery = `SELECT COALESCE(pg_sequence_last_value(pg_get_serial_sequence($1, 'global_position')::regclass), 0)`
+	const snapshotQuery = `SELECT pg_snapshot_xmax(pg_current_snapshot())::text`
+	const endedQuery = `SELECT pg_snapshot_xmin(pg_current_snapshot()) >= $1::xid8`
+
+	var position int64
+	if err := r.db.QueryRowContext(ctx, positionQuery, r.eventsTable).Scan(&position); err != nil {
+		return 0, errors.Wrap(err, "reading last position")
+	}
+
+	// the transactions that drew positions up to the last one all have lower ids
+	var xmax string
+	if err := r.db.QueryRowContext(ctx, snapshotQuery).Scan(&xmax); err != nil {
+		return 0, errors.Wrap(err, "reading transaction snapshot")
+	}
+
+	ticker := time.NewTicker(settlePollInterval)
+	defer ticker.Stop()
+
+	for {
+		var ended bool
+		if err := r.db.QueryRowContext(ctx, endedQuery, xmax).Scan(&ended); err != nil {
+			return 0, errors.Wrap(err, "reading transaction snapshot")
+		}
+		if ended {
+			return position, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r Replayer) readCheckpoint(ctx context.Context, name string) (int64, error) {
+	const query = `SELECT position FROM %s WHERE projection = $1`
+
+	var position int64
+	err := r.db.QueryRowContext(ctx, fmt.Sprintf(query, r.checkpointsTable), name).Scan(&position)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.Wrap(err, "reading checkpoint")
+	}
+
+	return position, nil
+}
+
+func (r Replayer) writeCheckpoint(ctx context.Context, tx *sql.Tx, name string, position int64) error {
+	const query = `INSERT INTO %s (projection, position, updated_at) VALUES ($1, $2, $3)
+ON CONFLICT (projection) DO UPDATE SET position = EXCLUDED.position, updated_at = EXCLUDED.updated_at`
+
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(query, r.checkpointsTable), name, position, time.Now())
+
+	return errors.Wrap(err, "writing checkpoint")
+}
+
+func (r Replayer) deleteCheckpoint(ctx context.Context, tx *sql.Tx, name string) error {
+	const query = `DELETE FROM %s WHERE projection = $1`
+
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(query, r.checkpointsTable), name)
+
+	return errors.Wrap(err, "deleting checkpoint")
+}
+
+func unqualified(table string) string {
+	return table[strings.LastIndex(table, ".")+1:]
+}
@@ -0,0 +1,418 @@
+package replay
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/sqltest"
+)
+
+var streamStart = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+var testProjection = Projection{
+	Name:  "orders_view",
+	Table: "ordering.orders_view",
+}
+
+type storedEvent struct {
+	position int64
+	event
+	data []byte
+}
+
+// eventStore answers the statements of a rebuild the way the event store would.
+// Events past the last position drawn belong to transactions that have not
+// committed when the rebuild starts.
+type eventStore struct {
+	events []storedEvent
+	// lastPosition is the last global position drawn when the rebuild starts
+	lastPosition int64
+	// inFlightPolls is how many times the rebuild finds transactions in flight
+	inFlightPolls int
+	checkpoints   map[string]int64
+	statements    []string
+	polls         int
+}
+
+func (s *eventStore) handle(query string, args []driver.Value) (*sqltest.Rows, error) {
+	s.statements = append(s.statements, query)
+
+	switch {
+	case query == "BEGIN", query == "COMMIT", query == "ROLLBACK":
+		return nil, nil
+
+	case strings.Contains(query, "pg_sequence_last_value"):
+		return sqltest.Row(s.lastPosition), nil
+	case strings.Contains(query, "pg_snapshot_xmax"):
+		return sqltest.Row("1000"), nil
+	case strings.Contains(query, "pg_snapshot_xmin"):
+		s.polls++
+		return sqltest.Row(s.polls > s.inFlightPolls), nil
+
+	case strings.HasPrefix(query, "SELECT COUNT(*)"):
+		var count int64
+		for _, e := range s.events {
+			if e.position > args[1].(int64) && e.position <= args[2].(int64) {
+				count++
+			}
+		}
+		return sqltest.Row(count), nil
+
+	case strings.HasPrefix(query, "SELECT global_position"):
+		after, settled, limit := args[1].(int64), args[2].(int64), int(args[3].(int64))
+		rows := &sqltest.Rows{}
+		for _, e := range s.events {
+			if e.position > after && e.position <= settled && len(rows.Values) < limit {
+				rows.Values = append(rows.Values, []driver.Value{
+					e.position, e.aggregateID, int64(e.version), e.id, e.name, e.data, e.occurredAt,
+				})
+			}
+		}
+		return rows, nil
+
+	case strings.HasPrefix(query, "SELECT position"):
+		position, exists := s.checkpoints[args[0].(string)]
+		if !exists {
+			return &sqltest.Rows{}, nil
+		}
+		return sqltest.Row(position), nil
+	case strings.HasPrefix(query, "INSERT INTO ordering.projection_checkpoints"):
+		s.checkpoints[args[0].(string)] = args[1].(int64)
+		return sqltest.Affected(1), nil
+	case strings.HasPrefix(query, "DELETE FROM ordering.projection_checkpoints"):
+		delete(s.checkpoints, args[0].(string))
+		return sqltest.Affected(1), nil
+
+	case strings.HasPrefix(query, "DROP TABLE"), strings.HasPrefix(query, "CREATE TABLE"),
+		strings.HasPrefix(query, "LOCK TABLE"), strings.HasPrefix(query, "ALTER TABLE"):
+		return nil, nil
+	}
+
+	return nil, errors.Wrapf(errors.ErrUnimplemented, "unexpected query %q", query)
+}
+
+func (s *eventStore) index(statement string) int {
+	for i, stmt := range s.statements {
+		if stmt == statement {
+			return i
+		}
+	}
+	return -1
+}
+
+// orderRepository loads orders from the recorded events.
+type orderRepository struct {
+	events []storedEvent
+	loads  []domain.AsOf
+}
+
+func (r *orderRepository) Load(context.Context, string) (*domain.Order, error) {
+	return nil, errors.ErrUnimplemented
+}
+
+func (r *orderRepository) Save(context.Context, *domain.Order) error {
+	return errors.ErrUnimplemented
+}
+
+func (r *orderRepository) LoadAsOf(_ context.Context, orderID string, bound domain.AsOf) (*domain.Order, error) {
+	r.loads = append(r.loads, bound)
+
+	order := domain.NewOrder(orderID)
+	for _, e := range r.events {
+		if e.aggregateID == orderID && e.version <= bound.Version {
+			e.aggregate = order
+			if err := es.LoadEvent(order, e.event); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return order, nil
+}
+
+type projected struct {
+	table    string
+	position int64
+	status   domain.OrderStatus
+}
+
+type projector struct {
+	table     string
+	positions map[string]int64
+	projected *[]projected
+}
+
+func (p projector) Project(_ context.Context, event ddd.AggregateEvent, order *domain.Order) error {
+	*p.projected = append(*p.projected, projected{table: p.table, position: p.positions[event.ID()], status: order.Status})
+	return nil
+}
+
+func testRegistry(t *testing.T) registry.Registry {
+	t.Helper()
+
+	reg := registry.New()
+	serde := serdes.NewJsonSerde(reg)
+	for _, v := range []registry.Registrable{
+		domain.OrderCreated{},
+		domain.OrderPaymentConfirmed{},
+		domain.OrderApproved{},
+	} {
+		if err := serde.Register(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return reg
+}
+
+// recordStreams interleaves the events of two orders in the event store: both are
+// created and their payments confirmed before the first is approved.
+func recordStreams(t *testing.T, reg registry.Registry) []storedEvent {
+	t.Helper()
+
+	first, second := domain.NewOrder("order-1"), domain.NewOrder("order-2")
+	create := func(order *domain.Order) func() (ddd.Event, error) {
+		return func() (ddd.Event, error) {
+			return order.CreateOrder(order.ID(), "customer-1", "payment-1", []domain.Item{{
+				ProductID: "product-1",
+				StoreID:   "store-1",
+				Price:     domain.NewMoney(1250, domain.DefaultCurrency),
+				Quantity:  1,
+			}}, nil, nil, nil, domain.Fulfillment{})
+		}
+	}
+	steps := []struct {
+		order *domain.Order
+		step  func() (ddd.Event, error)
+	}{
+		{first, create(first)},
+		{second, create(second)},
+		{first, first.ConfirmPayment},
+		{second, second.ConfirmPayment},
+		{first, func() (ddd.Event, error) { return first.Approve("shopping-1") }},
+	}
+
+	var events []storedEvent
+	for _, s := range steps {
+		if _, err := s.step(); err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range s.order.Events() {
+			if err := s.order.ApplyEvent(e); err != nil {
+				t.Fatal(err)
+			}
+			data, err := reg.Serialize(e.EventName(), e.Payload())
+			if err != nil {
+				t.Fatal(err)
+			}
+			events = append(events, storedEvent{
+				position: int64(len(events) + 1),
+				event: event{
+					id:          e.ID(),
+					name:        e.EventName(),
+					payload:     e.Payload(),
+					occurredAt:  streamStart.Add(time.Duration(len(events)) * time.Minute),
+					aggregateID: s.order.ID(),
+					version:     s.order.PendingVersion() - len(s.order.Events()) + 1,
+				},
+				data: data,
+			})
+		}
+		s.order.CommitEvents()
+	}
+
+	return events
+}
+
+func newTestReplayer(t *testing.T, store *eventStore, orders *orderRepository, projections *[]projected) (Replayer, Projection) {
+	t.Helper()
+
+	positions := make(map[string]int64)
+	for _, e := range store.events {
+		positions[e.id] = e.position
+	}
+
+	projection := testProjection
+	projection.New = func(_ pg.DB, table string) Projector {
+		return projector{table: table, positions: positions, projected: projections}
+	}
+
+	replayer := NewReplayer(sqltest.Open(store.handle), "ordering.events", "ordering.projection_checkpoints", testRegistry(t),
+		func(*sql.Tx) domain.OrderRepository { return orders },
+		io.Discard,
+	)
+
+	return replayer, projection
+}
+
+func TestReplayer_Rebuild(t *testing.T) {
+	events := recordStreams(t, testRegistry(t))
+
+	tests := map[string]struct {
+		opts            Options
+		checkpoint      int64
+		lastPosition    int64
+		inFlightPolls   int
+		wantProjected   []projected
+		wantCheckpoints map[string]int64
+		wantLoads       []domain.AsOf
+	}{
+		"from the first event": {
+			opts:         Options{BatchSize: 2},
+			lastPosition: 5,
+			wantProjected: []projected{
+				{"ordering.orders_view", 1, domain.OrderIsPending},
+				{"ordering.orders_view", 2, domain.OrderIsPending},
+				{"ordering.orders_view", 3, domain.OrderIsPending},
+				{"ordering.orders_view", 4, domain.OrderIsPending},
+				{"ordering.orders_view", 5, domain.OrderIsApproved},
+			},
+			wantCheckpoints: map[string]int64{"orders_view": 5},
+		},
+		"up to the settled position": {
+			opts:          Options{BatchSize: 2},
+			lastPosition:  3,
+			inFlightPolls: 2,
+			wantProjected: []projected{
+				{"ordering.orders_view", 1, domain.OrderIsPending},
+				{"ordering.orders_view", 2, domain.OrderIsPending},
+				{"ordering.orders_view", 3, domain.OrderIsPending},
+			},
+			wantCheckpoints: map[string]int64{"orders_view": 3},
+		},
+		"resumed": {
+			opts:         Options{BatchSize: 10, Resume: true},
+			checkpoint:   3,
+			lastPosition: 5,
+			wantProjected: []projected{
+				{"ordering.orders_view", 4, domain.OrderIsPending},
+				{"ordering.orders_view", 5, domain.OrderIsApproved},
+			},
+			wantCheckpoints: map[string]int64{"orders_view": 5},
+			wantLoads:       []domain.AsOf{{Version: 1}, {Version: 2}},
+		},
+		"dry run": {
+			opts:            Options{BatchSize: 2, DryRun: true},
+			lastPosition:    5,
+			wantCheckpoints: map[string]int64{},
+		},
+		"shadow": {
+			opts:         Options{BatchSize: 2, Shadow: true},
+			lastPosition: 3,
+			wantProjected: []projected{
+				{"ordering.orders_view_shadow", 1, domain.OrderIsPending},
+				{"ordering.orders_view_shadow", 2, domain.OrderIsPending},
+				{"ordering.orders_view_shadow", 3, domain.OrderIsPending},
+				// the catch-up of the swap replays every event there is
+				{"ordering.orders_view_shadow", 4, domain.OrderIsPending},
+				{"ordering.orders_view_shadow", 5, domain.OrderIsApproved},
+			},
+			wantCheckpoints: map[string]int64{"orders_view": 5},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := &eventStore{
+				events:        events,
+				lastPosition:  tc.lastPosition,
+				inFlightPolls: tc.inFlightPolls,
+				checkpoints:   make(map[string]int64),
+			}
+			if tc.checkpoint != 0 {
+				store.checkpoints["orders_view"] = tc.checkpoint
+			}
+			orders := &orderRepository{events: events}
+			var projections []projected
+
+			replayer, projection := newTestReplayer(t, store, orders, &projections)
+			if err := replayer.Rebuild(context.Background(), projection, tc.opts); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(projections, tc.wantProjected) {
+				t.Errorf("projected %v, want %v", projections, tc.wantProjected)
+			}
+			if !reflect.DeepEqual(store.checkpoints, tc.wantCheckpoints) {
+				t.Errorf("checkpoints = %v, want %v", store.checkpoints, tc.wantCheckpoints)
+			}
+			if !reflect.DeepEqual(orders.loads, tc.wantLoads) {
+				t.Errorf("orders loaded as of %v, want %v", orders.loads, tc.wantLoads)
+			}
+			if store.polls != tc.inFlightPolls+1 {
+				t.Errorf("polled the snapshot %d times, want %d", store.polls, tc.inFlightPolls+1)
+			}
+		})
+	}
+}
+
+func TestReplayer_RebuildCheckpointsEveryBatch(t *testing.T) {
+	events := recordStreams(t, testRegistry(t))
+
+	store := &eventStore{events: events, lastPosition: 5, checkpoints: make(map[string]int64)}
+	var projections []projected
+	replayer, projection := newTestReplayer(t, store, &orderRepository{events: events}, &projections)
+
+	if err := replayer.Rebuild(context.Background(), projection, Options{BatchSize: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	var checkpoints, commits int
+	for i, stmt := range store.statements {
+		switch {
+		case strings.HasPrefix(stmt, "INSERT INTO ordering.projection_checkpoints"):
+			checkpoints++
+			if next := store.statements[i+1]; next != "COMMIT" {
+				t.Errorf("checkpoint followed by %q, want it committed with its batch", next)
+			}
+		case stmt == "COMMIT":
+			commits++
+		}
+	}
+	// batches of 2, 2 and 1 events
+	if checkpoints != 3 || commits != 3 {
+		t.Errorf("wrote %d checkpoints in %d transactions, want 3 in 3", checkpoints, commits)
+	}
+}
+
+func TestReplayer_RebuildSwapLocksEventsFirst(t *testing.T) {
+	events := recordStreams(t, testRegistry(t))
+
+	store := &eventStore{events: events, lastPosition: 3, checkpoints: make(map[string]int64)}
+	var projections []projected
+	replayer, projection := newTestReplayer(t, store, &orderRepository{events: events}, &projections)
+
+	if err := replayer.Rebuild(context.Background(), projection, Options{BatchSize: 2, Shadow: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	lockEvents := store.index("LOCK TABLE ordering.events IN SHARE MODE")
+	lockTable := store.index("LOCK TABLE ordering.orders_view IN EXCLUSIVE MODE")
+	if lockEvents == -1 || lockTable == -1 || lockEvents > lockTable {
+		t.Errorf("locked the events at statement %d and the table at %d, want the events locked first", lockEvents, lockTable)
+	}
+
+	var catchUp bool
+	for _, stmt := range store.statements[lockTable:] {
+		catchUp = catchUp || strings.HasPrefix(stmt, "SELECT global_position")
+	}
+	if !catchUp {
+		t.Error("the swap did not catch up with the events")
+	}
+	if _, exists := store.checkpoints["orders_view:shadow"]; exists {
+		t.Error("the shadow checkpoint was kept after the swap")
+	}
+}
@@ -0,0 +1,30 @@
+package returnspb
+
+import (
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+)
+
+// The return events are published on the order aggregate channel of
+// mallbots-ordering-proto alongside the other order events.
+const (
+	ReturnApprovedEvent = "ordersapi.ReturnApproved"
+	ReturnReceivedEvent = "ordersapi.ReturnReceived"
+)
+
+func Registrations(reg registry.Registry) (err error) {
+	serde := serdes.NewProtoSerde(reg)
+
+	// Return events
+	if err = serde.Register(&ReturnApproved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&ReturnReceived{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*ReturnApproved) Key() string { return ReturnApprovedEvent }
+func (*ReturnReceived) Key() string { return ReturnReceivedEvent }
@@ -0,0 +1,6 @@
+// Package returnspb holds the API and integration events for returning items of
+// picked up orders. They extend the ordering API of mallbots-ordering-proto until
+// that module carries them.
+package returnspb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=returnspb/api.annotations.yaml returnspb/returns.api.proto returnspb/returns.events.proto
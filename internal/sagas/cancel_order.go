@@ -0,0 +1,76 @@
+package sagas
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/am"
+	"github.com/v8tix/eda/sec"
+	depotpb "github.com/v8tix/mallbots-depot-proto/pb"
+	"github.com/v8tix/mallbots-ordering-proto/pb"
+)
+
+const (
+	CancelOrderSagaName     = "ordering.CancelOrderSaga"
+	CancelOrderReplyChannel = "mallbots.ordering.replies.CancelOrderSaga"
+)
+
+// CancelOrderData is the state the saga keeps for an order being cancelled; the
+// saga is identified by the order id.
+type CancelOrderData struct {
+	OrderID    string
+	PaymentID  string
+	ShoppingID string
+}
+
+type cancelOrderSaga struct {
+	sec.Saga[*CancelOrderData]
+}
+
+// NewCancelOrderSaga cancels an approved order once the depot has dropped its
+// shopping list and the payment is voided. A refusal returns the order to the status
+// it was cancelled from. The depot may refuse to drop the list, so the payment is
+// only voided after it has; a void is never refused, as failures to reach the
+// payments service are redelivered, so an order never goes back into business
+// with a voided payment.
+func NewCancelOrderSaga() sec.Saga[*CancelOrderData] {
+	saga := cancelOrderSaga{
+		Saga: sec.NewSaga[*CancelOrderData](CancelOrderSagaName, CancelOrderReplyChannel),
+	}
+
+	// 0. -AbortCancellation
+	saga.AddStep().
+		Compensation(saga.abortCancellation)
+
+	// 1. CancelShoppingList
+	saga.AddStep().
+		Action(saga.cancelShoppingList)
+
+	// 2. VoidPayment
+	saga.AddStep().
+		Action(saga.voidPayment)
+
+	// 3. CompleteCancellation
+	saga.AddStep().
+		Action(saga.completeCancellation)
+
+	return saga
+}
+
+func (s cancelOrderSaga) abortCancellation(ctx context.Context, data *CancelOrderData) am.Command {
+	return am.NewCommand(AbortCancellationCommand, pb.CommandChannel, &AbortCancellation{OrderID: data.OrderID})
+}
+
+func (s cancelOrderSaga) cancelShoppingList(ctx context.Context, data *CancelOrderData) am.Command {
+	return am.NewCommand(depotpb.CancelShoppingListCommand, depotpb.CommandChannel, &depotpb.CancelShoppingList{Id: data.ShoppingID})
+}
+
+func (s cancelOrderSaga) voidPayment(ctx context.Context, data *CancelOrderData) am.Command {
+	return am.NewCommand(VoidPaymentCommand, pb.CommandChannel, &VoidPayment{
+		OrderID:   data.OrderID,
+		PaymentID: data.PaymentID,
+	})
+}
+
+func (s cancelOrderSaga) completeCancellation(ctx context.Context, data *CancelOrderData) am.Command {
+	return am.NewCommand(CompleteCancellationCommand, pb.CommandChannel, &CompleteCancellation{OrderID: data.OrderID})
+}
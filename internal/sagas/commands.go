@@ -0,0 +1,50 @@
+package sagas
+
+// The ordering service sends these commands to itself; they have no counterpart
+// in the ordering protocol buffers and are serialized as JSON.
+const (
+	AuthorizeCustomerCommand = "ordersapi.AuthorizeCustomer"
+	ConfirmPaymentCommand    = "ordersapi.ConfirmPayment"
+	VoidPaymentCommand       = "ordersapi.VoidPayment"
+
+	CompleteCancellationCommand = "ordersapi.CompleteCancellation"
+	AbortCancellationCommand    = "ordersapi.AbortCancellation"
+)
+
+// RefusedReply is the failure reply to a command the ordering service refused for
+// good, as opposed to one that failed for a transient reason and is redelivered.
+const RefusedReply = "ordersapi.Refused"
+
+type Refused struct {
+	Reason string
+}
+
+type AuthorizeCustomer struct {
+	OrderID    string
+	CustomerID string
+}
+
+type ConfirmPayment struct {
+	OrderID   string
+	PaymentID string
+}
+
+type VoidPayment struct {
+	OrderID   string
+	PaymentID string
+}
+
+type CompleteCancellation struct {
+	OrderID string
+}
+
+type AbortCancellation struct {
+	OrderID string
+}
+
+func (Refused) Key() string              { return RefusedReply }
+func (AuthorizeCustomer) Key() string    { return AuthorizeCustomerCommand }
+func (ConfirmPayment) Key() string       { return ConfirmPaymentCommand }
+func (VoidPayment) Key() string          { return VoidPaymentCommand }
+func (CompleteCancellation) Key() string { return CompleteCancellationCommand }
+func (AbortCancellation) Key() string    { return AbortCancellationCommand }
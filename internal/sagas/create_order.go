@@ -0,0 +1,148 @@
+package sagas
+
+import (
+	"context"
+
+	"github.com/v8tix/eda/am"
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/sec"
+	depotpb "github.com/v8tix/mallbots-depot-proto/pb"
+	"github.com/v8tix/mallbots-ordering-proto/pb"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+const (
+	CreateOrderSagaName     = "ordering.CreateOrderSaga"
+	CreateOrderReplyChannel = "mallbots.ordering.replies.CreateOrderSaga"
+)
+
+// createOrderSteps names the saga steps in the order they are added.
+var createOrderSteps = []string{
+	"reject order",
+	"authorize customer",
+	"create shopping list",
+	"confirm payment",
+	"approve order",
+}
+
+// CreateOrderData is the state the saga keeps for an order; the saga is
+// identified by the order id.
+type CreateOrderData struct {
+	OrderID    string
+	CustomerID string
+	PaymentID  string
+	ShoppingID string
+	Items      []domain.Item
+	// RefusalReason is why the step the saga is compensating for was refused
+	RefusalReason string
+}
+
+type createOrderSaga struct {
+	sec.Saga[*CreateOrderData]
+}
+
+// NewCreateOrderSaga takes a pending order through to approval. Any failed step
+// undoes the steps before it, ending with the rejection of the order. The commands
+// sent to this service only fail once they are refused, with a RefusedReply giving
+// the reason; transient failures are redelivered instead of compensated.
+func NewCreateOrderSaga() sec.Saga[*CreateOrderData] {
+	saga := createOrderSaga{
+		Saga: sec.NewSaga[*CreateOrderData](CreateOrderSagaName, CreateOrderReplyChannel),
+	}
+
+	// 0. -RejectOrder
+	saga.AddStep().
+		Compensation(saga.rejectOrder)
+
+	// 1. AuthorizeCustomer
+	saga.AddStep().
+		Action(saga.authorizeCustomer).
+		OnActionReply(RefusedReply, saga.onRefusedReply)
+
+	// 2. CreateShoppingList, -CancelShoppingList
+	saga.AddStep().
+		Action(saga.createShoppingList).
+		OnActionReply(depotpb.CreatedShoppingListReply, saga.onCreatedShoppingListReply).
+		Compensation(saga.cancelShoppingList)
+
+	// 3. ConfirmPayment, -VoidPayment
+	saga.AddStep().
+		Action(saga.confirmPayment).
+		OnActionReply(RefusedReply, saga.onRefusedReply).
+		Compensation(saga.voidPayment)
+
+	// 4. ApproveOrder
+	saga.AddStep().
+		Action(saga.approveOrder).
+		OnActionReply(RefusedReply, saga.onRefusedReply)
+
+	return saga
+}
+
+func (s createOrderSaga) rejectOrder(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(pb.RejectOrderCommand, pb.CommandChannel, &pb.RejectOrder{Id: data.OrderID})
+}
+
+func (s createOrderSaga) onRefusedReply(ctx context.Context, data *CreateOrderData, reply ddd.Reply) error {
+	payload := reply.Payload().(*Refused)
+
+	data.RefusalReason = payload.Reason
+
+	return nil
+}
+
+func (s createOrderSaga) authorizeCustomer(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(AuthorizeCustomerCommand, pb.CommandChannel, &AuthorizeCustomer{
+		OrderID:    data.OrderID,
+		CustomerID: data.CustomerID,
+	})
+}
+
+func (s createOrderSaga) createShoppingList(ctx context.Context, data *CreateOrderData) am.Command {
+	items := make([]*depotpb.CreateShoppingList_Item, len(data.Items))
+	for i, item := range data.Items {
+		items[i] = &depotpb.CreateShoppingList_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(item.Quantity),
+		}
+	}
+
+	return am.NewCommand(depotpb.CreateShoppingListCommand, depotpb.CommandChannel, &depotpb.CreateShoppingList{
+		OrderId: data.OrderID,
+		Items:   items,
+	})
+}
+
+func (s createOrderSaga) onCreatedShoppingListReply(ctx context.Context, data *CreateOrderData, reply ddd.Reply) error {
+	payload := reply.Payload().(*depotpb.CreatedShoppingList)
+
+	data.ShoppingID = payload.GetId()
+
+	return nil
+}
+
+func (s createOrderSaga) cancelShoppingList(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(depotpb.CancelShoppingListCommand, depotpb.CommandChannel, &depotpb.CancelShoppingList{Id: data.ShoppingID})
+}
+
+func (s createOrderSaga) confirmPayment(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(ConfirmPaymentCommand, pb.CommandChannel, &ConfirmPayment{
+		OrderID:   data.OrderID,
+		PaymentID: data.PaymentID,
+	})
+}
+
+func (s createOrderSaga) voidPayment(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(VoidPaymentCommand, pb.CommandChannel, &VoidPayment{
+		OrderID:   data.OrderID,
+		PaymentID: data.PaymentID,
+	})
+}
+
+func (s createOrderSaga) approveOrder(ctx context.Context, data *CreateOrderData) am.Command {
+	return am.NewCommand(pb.ApproveOrderCommand, pb.CommandChannel, &pb.ApproveOrder{
+		Id:         data.OrderID,
+		ShoppingId: data.ShoppingID,
+	})
+}
@@ -0,0 +1,45 @@
+package sagas
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/sec"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+type OrderSagaRepository struct {
+	sagas sec.SagaRepository[*CreateOrderData]
+}
+
+var _ domain.OrderSagaRepository = (*OrderSagaRepository)(nil)
+
+func NewOrderSagaRepository(reg registry.Registry, store sec.SagaStore) OrderSagaRepository {
+	return OrderSagaRepository{sagas: sec.NewSagaRepository[*CreateOrderData](reg, store)}
+}
+
+func (r OrderSagaRepository) Find(ctx context.Context, orderID string) (*domain.OrderSaga, error) {
+	sagaCtx, err := r.sagas.Load(ctx, CreateOrderSagaName, orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOrderSagaNotFound
+		}
+		return nil, err
+	}
+
+	var step string
+	if sagaCtx.Step >= 0 && sagaCtx.Step < len(createOrderSteps) {
+		step = createOrderSteps[sagaCtx.Step]
+	}
+
+	return &domain.OrderSaga{
+		OrderID:       sagaCtx.ID,
+		Step:          step,
+		Done:          sagaCtx.Done,
+		Compensating:  sagaCtx.Compensating,
+		RefusalReason: sagaCtx.Data.RefusalReason,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package schedulingpb
+
+import (
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+)
+
+// OrderRescheduledEvent is published on the order aggregate channel of
+// mallbots-ordering-proto alongside the other order events. ScheduledOrderCreated
+// is not registered; it is published under the OrderCreated key of that module.
+const OrderRescheduledEvent = "ordersapi.OrderRescheduled"
+
+func Registrations(reg registry.Registry) (err error) {
+	serde := serdes.NewProtoSerde(reg)
+
+	// Order events
+	if err = serde.Register(&OrderRescheduled{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*OrderRescheduled) Key() string { return OrderRescheduledEvent }
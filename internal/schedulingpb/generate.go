@@ -0,0 +1,6 @@
+// Package schedulingpb holds the API and integration events for orders handed over
+// in a time slot. They extend the ordering API of mallbots-ordering-proto until
+// that module carries them.
+package schedulingpb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=schedulingpb/api.annotations.yaml schedulingpb/scheduling.api.proto schedulingpb/scheduling.events.proto
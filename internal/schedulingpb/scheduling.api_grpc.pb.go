@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: schedulingpb/scheduling.api.proto
+
+package schedulingpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OrderSchedulingService_CreateScheduledOrder_FullMethodName = "/pb.OrderSchedulingService/CreateScheduledOrder"
+	OrderSchedulingService_RescheduleOrder_FullMethodName      = "/pb.OrderSchedulingService/RescheduleOrder"
+)
+
+// OrderSchedulingServiceClient is the client API for OrderSchedulingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderSchedulingServiceClient interface {
+	CreateScheduledOrder(ctx context.Context, in *CreateScheduledOrderRequest, opts ...grpc.CallOption) (*CreateScheduledOrderResponse, error)
+	RescheduleOrder(ctx context.Context, in *RescheduleOrderRequest, opts ...grpc.CallOption) (*RescheduleOrderResponse, error)
+}
+
+type orderSchedulingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderSchedulingServiceClient(cc grpc.ClientConnInterface) OrderSchedulingServiceClient {
+	return &orderSchedulingServiceClient{cc}
+}
+
+func (c *orderSchedulingServiceClient) CreateScheduledOrder(ctx context.Context, in *CreateScheduledOrderRequest, opts ...grpc.CallOption) (*CreateScheduledOrderResponse, error) {
+	out := new(CreateScheduledOrderResponse)
+	err := c.cc.Invoke(ctx, OrderSchedulingService_CreateScheduledOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderSchedulingServiceClient) RescheduleOrder(ctx context.Context, in *RescheduleOrderRequest, opts ...grpc.CallOption) (*RescheduleOrderResponse, error) {
+	out := new(RescheduleOrderResponse)
+	err := c.cc.Invoke(ctx, OrderSchedulingService_RescheduleOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderSchedulingServiceServer is the server API for OrderSchedulingService service.
+// All implementations must embed UnimplementedOrderSchedulingServiceServer
+// for forward compatibility
+type OrderSchedulingServiceServer interface {
+	CreateScheduledOrder(context.Context, *CreateScheduledOrderRequest) (*CreateScheduledOrderResponse, error)
+	RescheduleOrder(context.Context, *RescheduleOrderRequest) (*RescheduleOrderResponse, error)
+	mustEmbedUnimplementedOrderSchedulingServiceServer()
+}
+
+// UnimplementedOrderSchedulingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrderSchedulingServiceServer struct {
+}
+
+func (UnimplementedOrderSchedulingServiceServer) CreateScheduledOrder(context.Context, *CreateScheduledOrderRequest) (*CreateScheduledOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledOrder not implemented")
+}
+func (UnimplementedOrderSchedulingServiceServer) RescheduleOrder(context.Context, *RescheduleOrderRequest) (*RescheduleOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleOrder not implemented")
+}
+func (UnimplementedOrderSchedulingServiceServer) mustEmbedUnimplementedOrderSchedulingServiceServer() {
+}
+
+// UnsafeOrderSchedulingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderSchedulingServiceServer will
+// result in compilation errors.
+type UnsafeOrderSchedulingServiceServer interface {
+	mustEmbedUnimplementedOrderSchedulingServiceServer()
+}
+
+func RegisterOrderSchedulingServiceServer(s grpc.ServiceRegistrar, srv OrderSchedulingServiceServer) {
+	s.RegisterService(&OrderSchedulingService_ServiceDesc, srv)
+}
+
+func _OrderSchedulingService_CreateScheduledOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderSchedulingServiceServer).CreateScheduledOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderSchedulingService_CreateScheduledOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderSchedulingServiceServer).CreateScheduledOrder(ctx, req.(*CreateScheduledOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderSchedulingService_RescheduleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderSchedulingServiceServer).RescheduleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderSchedulingService_RescheduleOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderSchedulingServiceServer).RescheduleOrder(ctx, req.(*RescheduleOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderSchedulingService_ServiceDesc is the grpc.ServiceDesc for OrderSchedulingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderSchedulingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.OrderSchedulingService",
+	HandlerType: (*OrderSchedulingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateScheduledOrder",
+			Handler:    _OrderSchedulingService_CreateScheduledOrder_Handler,
+		},
+		{
+			MethodName: "RescheduleOrder",
+			Handler:    _OrderSchedulingService_RescheduleOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "schedulingpb/scheduling.api.proto",
+}
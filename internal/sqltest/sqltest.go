@@ -0,0 +1,169 @@
+// Package sqltest opens *sql.DB handles that hand every statement to a function
+// instead of a database, so that code running queries can be tested without
+// Postgres.
+package sqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+
+	"github.com/stackus/errors"
+)
+
+// Handler answers the statements run on a DB. Transactions are reported to it as
+// the statements BEGIN, COMMIT and ROLLBACK. The rows it returns for a statement
+// run with Exec only count through RowsAffected.
+type Handler func(query string, args []driver.Value) (*Rows, error)
+
+// Rows is the result of a statement.
+type Rows struct {
+	Columns      []string
+	Values       [][]driver.Value
+	RowsAffected int64
+}
+
+// Open returns a DB that runs its statements with handler.
+func Open(handler Handler) *sql.DB {
+	return sql.OpenDB(connector{handler: handler})
+}
+
+// Affected returns the result of a statement that changed n rows.
+func Affected(n int64) *Rows {
+	return &Rows{RowsAffected: n}
+}
+
+// Row returns a result of a single row.
+func Row(values ...driver.Value) *Rows {
+	rows := &Rows{Values: [][]driver.Value{values}}
+	for range values {
+		rows.Columns = append(rows.Columns, "")
+	}
+	return rows
+}
+
+type connector struct {
+	handler Handler
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) { return conn(c), nil }
+func (c connector) Driver() driver.Driver                        { return sqltestDriver{} }
+
+type sqltestDriver struct{}
+
+func (sqltestDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.Wrap(errors.ErrUnimplemented, "sqltest databases are opened with sqltest.Open")
+}
+
+type conn struct {
+	handler Handler
+}
+
+var _ interface {
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.ConnBeginTx
+} = (*conn)(nil)
+
+func (c conn) Prepare(query string) (driver.Stmt, error) { return stmt{conn: c, query: query}, nil }
+func (c conn) Close() error                              { return nil }
+func (c conn) Begin() (driver.Tx, error)                 { return c.BeginTx(context.Background(), driver.TxOptions{}) }
+
+func (c conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.handler("BEGIN", nil); err != nil {
+		return nil, err
+	}
+	return tx(c), nil
+}
+
+func (c conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result, err := c.handler(query, values(args))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = &Rows{}
+	}
+	return &rows{Rows: result}, nil
+}
+
+func (c conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := c.handler(query, values(args))
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = &Rows{}
+	}
+	return driver.RowsAffected(result.RowsAffected), nil
+}
+
+type stmt struct {
+	conn  conn
+	query string
+}
+
+func (s stmt) Close() error  { return nil }
+func (s stmt) NumInput() int { return -1 }
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, named(args))
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, named(args))
+}
+
+type tx struct {
+	handler Handler
+}
+
+func (t tx) Commit() error {
+	_, err := t.handler("COMMIT", nil)
+	return err
+}
+
+func (t tx) Rollback() error {
+	_, err := t.handler("ROLLBACK", nil)
+	return err
+}
+
+type rows struct {
+	*Rows
+	next int
+}
+
+func (r *rows) Columns() []string {
+	if len(r.Rows.Columns) == 0 && len(r.Values) != 0 {
+		return make([]string, len(r.Values[0]))
+	}
+	return r.Rows.Columns
+}
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.Values) {
+		return io.EOF
+	}
+	copy(dest, r.Values[r.next])
+	r.next++
+	return nil
+}
+
+func values(args []driver.NamedValue) []driver.Value {
+	vs := make([]driver.Value, len(args))
+	for i, arg := range args {
+		vs[i] = arg.Value
+	}
+	return vs
+}
+
+func named(args []driver.Value) []driver.NamedValue {
+	nvs := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		nvs[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return nvs
+}
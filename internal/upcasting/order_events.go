@@ -0,0 +1,60 @@
+package upcasting
+
+import (
+	"encoding/json"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+// OrderEvents registers the upcasters of the order events.
+//
+// v1 -> v2 of OrderCreated: item prices were bare floats in the default currency and
+// became Money objects. OrderItemAdded was introduced with Money prices and is still
+// at v1.
+func OrderEvents(upcasters *Upcasters) (err error) {
+	if err = upcasters.Register(domain.OrderCreatedEvent, 1, orderCreatedV1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func orderCreatedV1(payload Payload) (Payload, error) {
+	items, _ := payload["Items"].([]any)
+	for _, item := range items {
+		if err := priceToMoney(item); err != nil {
+			return nil, err
+		}
+	}
+
+	return payload, nil
+}
+
+// priceToMoney leaves prices that are already Money objects alone; v1 events recorded
+// after Money was introduced carry them.
+func priceToMoney(item any) error {
+	fields, ok := item.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	price, ok := fields["Price"].(json.Number)
+	if !ok {
+		return nil
+	}
+
+	amount, err := price.Float64()
+	if err != nil {
+		return errors.Wrap(errors.ErrInvalidArgument, "the item price is not a number")
+	}
+
+	money := domain.MoneyFromFloat(amount, domain.DefaultCurrency)
+	fields["Price"] = map[string]any{
+		"Amount":   money.Amount,
+		"Currency": money.Currency,
+	}
+
+	return nil
+}
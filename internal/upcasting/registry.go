@@ -0,0 +1,70 @@
+package upcasting
+
+import (
+	"encoding/json"
+
+	"github.com/v8tix/eda/registry"
+)
+
+// envelope is the stored form of an event payload. Rows written before the envelope
+// was introduced hold the bare payload and are read as schema version 1.
+type envelope struct {
+	SchemaVersion int             `json:"schema_version"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Registry wraps event payloads in a versioned envelope when they are serialized and
+// upcasts them to their current schema before they are deserialized.
+type Registry struct {
+	registry.Registry
+	upcasters *Upcasters
+}
+
+var _ registry.Registry = (*Registry)(nil)
+
+func NewRegistry(reg registry.Registry, upcasters *Upcasters) Registry {
+	return Registry{
+		Registry:  reg,
+		upcasters: upcasters,
+	}
+}
+
+func (r Registry) Serialize(key string, v interface{}) ([]byte, error) {
+	data, err := r.Registry.Serialize(key, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(envelope{
+		SchemaVersion: r.upcasters.Version(key),
+		Payload:       data,
+	})
+}
+
+func (r Registry) Deserialize(key string, data []byte, options ...registry.BuildOption) (interface{}, error) {
+	version, payload := open(data)
+
+	payload, err := r.upcasters.Upcast(key, version, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Registry.Deserialize(key, payload, options...)
+}
+
+// open returns the schema version and payload of a stored event; event payloads
+// never hold exactly the two envelope fields, so anything else is a bare v1 payload.
+func open(data []byte) (int, []byte) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || len(fields) != 2 {
+		return 1, data
+	}
+
+	var version int
+	payload, exists := fields["payload"]
+	if !exists || json.Unmarshal(fields["schema_version"], &version) != nil || version == 0 {
+		return 1, data
+	}
+
+	return version, payload
+}
@@ -0,0 +1,146 @@
+package upcasting
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/registry"
+	"github.com/v8tix/eda/registry/serdes"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+)
+
+func testRegistry(t *testing.T) Registry {
+	t.Helper()
+
+	reg := registry.New()
+	serde := serdes.NewJsonSerde(reg)
+	for _, v := range []registry.Registrable{domain.OrderCreated{}, domain.OrderItemAdded{}} {
+		if err := serde.Register(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	upcasters := NewUpcasters()
+	if err := OrderEvents(upcasters); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewRegistry(reg, upcasters)
+}
+
+func TestRegistry_Deserialize(t *testing.T) {
+	price := domain.NewMoney(1250, domain.DefaultCurrency)
+
+	tests := map[string]struct {
+		key     string
+		data    string
+		want    any
+		wantErr error
+	}{
+		"OrderCreated v1 with a float price": {
+			key:  domain.OrderCreatedEvent,
+			data: `{"CustomerID":"customer-1","Items":[{"ProductID":"product-1","Price":12.5,"Quantity":2}]}`,
+			want: &domain.OrderCreated{CustomerID: "customer-1", Items: []domain.Item{{ProductID: "product-1", Price: price, Quantity: 2}}},
+		},
+		"OrderCreated v1 with a Money price": {
+			key:  domain.OrderCreatedEvent,
+			data: `{"CustomerID":"customer-1","Items":[{"ProductID":"product-1","Price":{"Amount":1250,"Currency":"USD"},"Quantity":2}]}`,
+			want: &domain.OrderCreated{CustomerID: "customer-1", Items: []domain.Item{{ProductID: "product-1", Price: price, Quantity: 2}}},
+		},
+		"OrderCreated v1 without items": {
+			key:  domain.OrderCreatedEvent,
+			data: `{"CustomerID":"customer-1"}`,
+			want: &domain.OrderCreated{CustomerID: "customer-1"},
+		},
+		"OrderCreated v2": {
+			key:  domain.OrderCreatedEvent,
+			data: `{"schema_version":2,"payload":{"CustomerID":"customer-1","Items":[{"ProductID":"product-1","Price":{"Amount":1250,"Currency":"USD"},"Quantity":2}]}}`,
+			want: &domain.OrderCreated{CustomerID: "customer-1", Items: []domain.Item{{ProductID: "product-1", Price: price, Quantity: 2}}},
+		},
+		"OrderCreated v1 with a price that is not a number": {
+			key:     domain.OrderCreatedEvent,
+			data:    `{"Items":[{"ProductID":"product-1","Price":1e400}]}`,
+			wantErr: errors.ErrInvalidArgument,
+		},
+		"OrderCreated in an unknown version": {
+			key:     domain.OrderCreatedEvent,
+			data:    `{"schema_version":3,"payload":{"CustomerID":"customer-1"}}`,
+			wantErr: errors.ErrInternal,
+		},
+		"OrderItemAdded v1": {
+			key:  domain.OrderItemAddedEvent,
+			data: `{"Item":{"ProductID":"product-1","Price":{"Amount":1250,"Currency":"USD"},"Quantity":1}}`,
+			want: &domain.OrderItemAdded{Item: domain.Item{ProductID: "product-1", Price: price, Quantity: 1}},
+		},
+		"OrderItemAdded v1 in an envelope": {
+			key:  domain.OrderItemAddedEvent,
+			data: `{"schema_version":1,"payload":{"Item":{"ProductID":"product-1","Price":{"Amount":1250,"Currency":"USD"},"Quantity":1}}}`,
+			want: &domain.OrderItemAdded{Item: domain.Item{ProductID: "product-1", Price: price, Quantity: 1}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := testRegistry(t).Deserialize(tc.key, []byte(tc.data))
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("payload = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_Serialize(t *testing.T) {
+	tests := map[string]struct {
+		key         string
+		payload     any
+		wantVersion int
+	}{
+		"OrderCreated": {
+			key:         domain.OrderCreatedEvent,
+			payload:     &domain.OrderCreated{CustomerID: "customer-1"},
+			wantVersion: 2,
+		},
+		"OrderItemAdded": {
+			key:         domain.OrderItemAddedEvent,
+			payload:     &domain.OrderItemAdded{Item: domain.Item{ProductID: "product-1"}},
+			wantVersion: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reg := testRegistry(t)
+
+			data, err := reg.Serialize(tc.key, tc.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var stored envelope
+			if err = json.Unmarshal(data, &stored); err != nil {
+				t.Fatal(err)
+			}
+			if stored.SchemaVersion != tc.wantVersion {
+				t.Errorf("schema version = %d, want %d", stored.SchemaVersion, tc.wantVersion)
+			}
+
+			got, err := reg.Deserialize(tc.key, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.payload) {
+				t.Errorf("payload = %+v, want %+v", got, tc.payload)
+			}
+		})
+	}
+}
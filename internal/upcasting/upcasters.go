@@ -0,0 +1,83 @@
+package upcasting
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/stackus/errors"
+)
+
+// Payload is an event payload decoded into generic JSON values; numbers are kept as
+// json.Number so that upcasting does not lose precision on untouched fields.
+type Payload map[string]any
+
+// Upcaster rewrites the payload of one schema version of an event into the next.
+type Upcaster func(payload Payload) (Payload, error)
+
+// Upcasters holds the upcaster chain of every event name. An event without any
+// upcaster is at schema version 1; each registered upcaster adds one version.
+type Upcasters struct {
+	chains map[string][]Upcaster
+	mu     sync.RWMutex
+}
+
+func NewUpcasters() *Upcasters {
+	return &Upcasters{
+		chains: make(map[string][]Upcaster),
+	}
+}
+
+// Register adds the upcaster from the given version of an event to the next one.
+// Upcasters must be registered in version order, starting with version 1.
+func (u *Upcasters) Register(eventName string, fromVersion int, upcaster Upcaster) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if next := len(u.chains[eventName]) + 1; fromVersion != next {
+		return errors.Wrapf(errors.ErrInternal, "upcaster for %s v%d registered out of order; expected v%d", eventName, fromVersion, next)
+	}
+
+	u.chains[eventName] = append(u.chains[eventName], upcaster)
+
+	return nil
+}
+
+// Version returns the schema version new events with the given name are written in.
+func (u *Upcasters) Version(eventName string) int {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return len(u.chains[eventName]) + 1
+}
+
+// Upcast brings a payload written in the given schema version up to the current one.
+func (u *Upcasters) Upcast(eventName string, version int, data []byte) ([]byte, error) {
+	u.mu.RLock()
+	chain := u.chains[eventName]
+	u.mu.RUnlock()
+
+	switch {
+	case version < 1 || version > len(chain)+1:
+		return nil, errors.Wrapf(errors.ErrInternal, "unknown schema version v%d of %s", version, eventName)
+	case version == len(chain)+1:
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var payload Payload
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s v%d", eventName, version)
+	}
+
+	for i, upcaster := range chain[version-1:] {
+		var err error
+		if payload, err = upcaster(payload); err != nil {
+			return nil, errors.Wrapf(err, "upcasting %s v%d", eventName, version+i)
+		}
+	}
+
+	return json.Marshal(payload)
+}
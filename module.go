@@ -15,21 +15,40 @@ import (
 	pg "github.com/v8tix/eda/postgres"
 	"github.com/v8tix/eda/registry"
 	"github.com/v8tix/eda/registry/serdes"
+	"github.com/v8tix/eda/sec"
 	"github.com/v8tix/eda/tm"
 	basketspb "github.com/v8tix/mallbots-baskets-proto/pb"
 	depotpb "github.com/v8tix/mallbots-depot-proto/pb"
 	"github.com/v8tix/mallbots-ordering-proto/pb"
 	"github.com/v8tix/mallbots-ordering-proto/rest"
+	"github.com/v8tix/mallbots-ordering/internal/amendmentspb"
 	"github.com/v8tix/mallbots-ordering/internal/application"
+	"github.com/v8tix/mallbots-ordering/internal/config"
 	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/fulfillmentpb"
+	"github.com/v8tix/mallbots-ordering/internal/gateway"
 	"github.com/v8tix/mallbots-ordering/internal/grpc"
 	"github.com/v8tix/mallbots-ordering/internal/handlers"
 	"github.com/v8tix/mallbots-ordering/internal/logging"
+	"github.com/v8tix/mallbots-ordering/internal/memory"
+	"github.com/v8tix/mallbots-ordering/internal/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/refundspb"
+	"github.com/v8tix/mallbots-ordering/internal/returnspb"
+	"github.com/v8tix/mallbots-ordering/internal/sagas"
+	"github.com/v8tix/mallbots-ordering/internal/schedulingpb"
+	"github.com/v8tix/mallbots-ordering/internal/upcasting"
 )
 
 type Module struct{}
 
 func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
+	if err = mono.Config().Customers.Validate("customers_cfg"); err != nil {
+		return err
+	}
+	if err = mono.Config().Payments.Validate("payments_cfg"); err != nil {
+		return err
+	}
+
 	container := di.New()
 	// setup Driven adapters
 	container.AddSingleton("registry", func(c di.Container) (any, error) {
@@ -46,8 +65,26 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 		if err := depotpb.Registrations(reg); err != nil {
 			return nil, err
 		}
+		if err := amendmentspb.Registrations(reg); err != nil {
+			return nil, err
+		}
+		if err := schedulingpb.Registrations(reg); err != nil {
+			return nil, err
+		}
+		if err := refundspb.Registrations(reg); err != nil {
+			return nil, err
+		}
+		if err := returnspb.Registrations(reg); err != nil {
+			return nil, err
+		}
+		if err := fulfillmentpb.Registrations(reg); err != nil {
+			return nil, err
+		}
 		return reg, nil
 	})
+	container.AddSingleton("eventRegistry", func(c di.Container) (any, error) {
+		return eventRegistry(c.Get("registry").(registry.Registry))
+	})
 	container.AddSingleton("logger", func(c di.Container) (any, error) {
 		return mono.Logger(), nil
 	})
@@ -63,12 +100,43 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 	container.AddSingleton("conn", func(c di.Container) (any, error) {
 		return grpc.Dial(ctx, mono.Config().RPC.Address())
 	})
+	container.AddSingleton("customers", func(c di.Container) (any, error) {
+		cfg := mono.Config().Customers
+		if cfg.UseFake() {
+			return memory.NewCustomerRepository(cfg.BlockedIDs), nil
+		}
+		conn, err := grpc.Dial(ctx, cfg.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return grpc.NewCustomerRepository(conn, cfg.Timeout), nil
+	})
+	container.AddSingleton("payments", func(c di.Container) (any, error) {
+		cfg := mono.Config().Payments
+		if cfg.UseFake() {
+			return memory.NewPaymentRepository(cfg.BlockedIDs), nil
+		}
+		conn, err := grpc.Dial(ctx, cfg.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return grpc.NewPaymentRepository(conn, cfg.Timeout, cfg.Retries, cfg.RetryBackoff), nil
+	})
 	container.AddSingleton("outboxProcessor", func(c di.Container) (any, error) {
 		return tm.NewOutboxProcessor(
 			c.Get("stream").(am.RawMessageStream),
 			pg.NewOutboxStore("ordering.outbox", c.Get("db").(*sql.DB)),
 		), nil
 	})
+	container.AddSingleton("idempotencyKeyPurger", func(c di.Container) (any, error) {
+		cfg := mono.Config().Idempotency
+		return handlers.NewIdempotencyKeyPurger(
+			postgres.NewIdempotencyKeyRepository("ordering.idempotency_keys", c.Get("db").(*sql.DB), cfg.TTL()),
+			cfg.PurgeInterval,
+			cfg.PurgeBatchSize,
+			c.Get("logger").(zerolog.Logger),
+		), nil
+	})
 	container.AddScoped("tx", func(c di.Container) (any, error) {
 		db := c.Get("db").(*sql.DB)
 		return db.Begin()
@@ -84,6 +152,9 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 	container.AddScoped("eventStream", func(c di.Container) (any, error) {
 		return am.NewEventStream(c.Get("registry").(registry.Registry), c.Get("txStream").(am.RawMessageStream)), nil
 	})
+	container.AddScoped("commandStream", func(c di.Container) (any, error) {
+		return am.NewCommandStream(c.Get("registry").(registry.Registry), c.Get("txStream").(am.RawMessageStream)), nil
+	})
 	container.AddScoped("replyStream", func(c di.Container) (any, error) {
 		return am.NewReplyStream(c.Get("registry").(registry.Registry), c.Get("txStream").(am.RawMessageStream)), nil
 	})
@@ -94,17 +165,85 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 	})
 	container.AddScoped("aggregateStore", func(c di.Container) (any, error) {
 		tx := c.Get("tx").(*sql.Tx)
-		reg := c.Get("registry").(registry.Registry)
 		return es.AggregateStoreWithMiddleware(
-			pg.NewEventStore("ordering.events", tx, reg),
-			pg.NewSnapshotStore("ordering.snapshots", tx, reg),
+			pg.NewEventStore("ordering.events", tx, c.Get("eventRegistry").(registry.Registry)),
+			postgres.NewSnapshotStore("ordering.snapshots", tx, c.Get("registry").(registry.Registry), snapshotStrategy(mono.Config().Snapshots)),
+			postgres.NewConcurrencyGuard("ordering.events", tx),
 		), nil
 	})
 	container.AddScoped("orders", func(c di.Container) (any, error) {
-		return es.NewAggregateRepository[*domain.Order](
-			domain.OrderAggregate,
+		return postgres.NewOrderRepository(
+			es.NewAggregateRepository[*domain.Order](
+				domain.OrderAggregate,
+				c.Get("registry").(registry.Registry),
+				c.Get("aggregateStore").(es.AggregateStore),
+			),
+			"ordering.events", "ordering.snapshots",
+			c.Get("tx").(*sql.Tx),
 			c.Get("registry").(registry.Registry),
-			c.Get("aggregateStore").(es.AggregateStore),
+			c.Get("eventRegistry").(registry.Registry),
+		), nil
+	})
+	container.AddScoped("promotions", func(c di.Container) (any, error) {
+		return postgres.NewPromotionRepository("ordering.promotions", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("taxes", func(c di.Container) (any, error) {
+		return postgres.NewTaxCalculator("ordering.tax_rates", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("productCategories", func(c di.Container) (any, error) {
+		return postgres.NewProductCategoryRepository("ordering.product_categories", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("deadlines", func(c di.Container) (any, error) {
+		return postgres.NewDeadlineRepository("ordering.deadlines", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("risk", func(c di.Container) (any, error) {
+		cfg := mono.Config().Risk
+		return postgres.NewRiskScorer("ordering.risk_activity", c.Get("tx").(*sql.Tx), domain.RiskRules{
+			MaxOrderTotal:  domain.MoneyFromFloat(cfg.MaxOrderTotal, domain.DefaultCurrency),
+			VelocityWindow: cfg.VelocityWindow,
+			VelocityLimit:  cfg.VelocityLimit,
+			HoldScore:      cfg.HoldScore,
+		}), nil
+	})
+	container.AddScoped("heldOrders", func(c di.Container) (any, error) {
+		return postgres.NewHeldOrderRepository("ordering.held_orders", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("orderViews", func(c di.Container) (any, error) {
+		return postgres.NewOrderViewRepository("ordering.orders_view", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("orderHistory", func(c di.Container) (any, error) {
+		return postgres.NewOrderHistoryRepository("ordering.events", c.Get("tx").(*sql.Tx), c.Get("eventRegistry").(registry.Registry)), nil
+	})
+	container.AddScoped("idempotencyKeys", func(c di.Container) (any, error) {
+		return postgres.NewIdempotencyKeyRepository("ordering.idempotency_keys", c.Get("tx").(*sql.Tx), mono.Config().Idempotency.TTL()), nil
+	})
+	container.AddScoped("slots", func(c di.Container) (any, error) {
+		return postgres.NewSlotCapacity("ordering.fulfillment_slots", "ordering.slot_reservations", c.Get("tx").(*sql.Tx)), nil
+	})
+	container.AddScoped("sagaStore", func(c di.Container) (any, error) {
+		return pg.NewSagaStore("ordering.sagas", c.Get("tx").(*sql.Tx), c.Get("registry").(registry.Registry)), nil
+	})
+	container.AddScoped("orderSagas", func(c di.Container) (any, error) {
+		return sagas.NewOrderSagaRepository(c.Get("registry").(registry.Registry), c.Get("sagaStore").(sec.SagaStore)), nil
+	})
+	container.AddScoped("createOrderSaga", func(c di.Container) (any, error) {
+		return sec.NewOrchestrator[*sagas.CreateOrderData](
+			sagas.NewCreateOrderSaga(),
+			sec.NewSagaRepository[*sagas.CreateOrderData](
+				c.Get("registry").(registry.Registry),
+				c.Get("sagaStore").(sec.SagaStore),
+			),
+			c.Get("commandStream").(am.CommandStream),
+		), nil
+	})
+	container.AddScoped("cancelOrderSaga", func(c di.Container) (any, error) {
+		return sec.NewOrchestrator[*sagas.CancelOrderData](
+			sagas.NewCancelOrderSaga(),
+			sec.NewSagaRepository[*sagas.CancelOrderData](
+				c.Get("registry").(registry.Registry),
+				c.Get("sagaStore").(sec.SagaStore),
+			),
+			c.Get("commandStream").(am.CommandStream),
 		), nil
 	})
 
@@ -113,6 +252,17 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 		return logging.LogApplicationAccess(
 			application.New(
 				c.Get("orders").(domain.OrderRepository),
+				c.Get("promotions").(domain.PromotionRepository),
+				c.Get("taxes").(domain.TaxCalculator),
+				c.Get("productCategories").(domain.ProductCategoryRepository),
+				c.Get("slots").(domain.SlotCapacity),
+				c.Get("risk").(domain.RiskScorer),
+				c.Get("heldOrders").(domain.HeldOrderRepository),
+				c.Get("orderViews").(domain.OrderViewRepository),
+				c.Get("orderHistory").(domain.OrderHistoryRepository),
+				c.Get("customers").(domain.CustomerRepository),
+				c.Get("payments").(domain.PaymentRepository),
+				c.Get("orderSagas").(domain.OrderSagaRepository),
 				c.Get("domainDispatcher").(*ddd.EventDispatcher[ddd.Event]),
 			),
 			c.Get("logger").(zerolog.Logger),
@@ -124,6 +274,55 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 			"DomainEvents", c.Get("logger").(zerolog.Logger),
 		), nil
 	})
+	container.AddScoped("slotEventHandlers", func(c di.Container) (any, error) {
+		return logging.LogEventHandlerAccess[ddd.Event](
+			handlers.NewSlotEventHandlers(c.Get("slots").(domain.SlotCapacity)),
+			"SlotEvents", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("holdEventHandlers", func(c di.Container) (any, error) {
+		return logging.LogEventHandlerAccess[ddd.Event](
+			handlers.NewHoldEventHandlers(c.Get("heldOrders").(domain.HeldOrderRepository)),
+			"HoldEvents", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("orderViewEventHandlers", func(c di.Container) (any, error) {
+		return logging.LogEventHandlerAccess[ddd.Event](
+			handlers.NewOrderViewEventHandlers(c.Get("orderViews").(domain.OrderViewRepository)),
+			"OrderViewEvents", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("deadlineEventHandlers", func(c di.Container) (any, error) {
+		return logging.LogEventHandlerAccess[ddd.Event](
+			handlers.NewDeadlineEventHandlers(
+				c.Get("deadlines").(domain.DeadlineRepository),
+				mono.Config().Expiry.PendingTimeout,
+			),
+			"DeadlineEvents", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("sagaEventHandlers", func(c di.Container) (any, error) {
+		return logging.LogEventHandlerAccess[ddd.Event](
+			handlers.NewSagaEventHandlers(
+				c.Get("createOrderSaga").(sec.Orchestrator[*sagas.CreateOrderData]),
+				c.Get("cancelOrderSaga").(sec.Orchestrator[*sagas.CancelOrderData]),
+				c.Get("orderSagas").(domain.OrderSagaRepository),
+			),
+			"SagaEvents", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("createOrderSagaReplyHandlers", func(c di.Container) (any, error) {
+		return logging.LogReplyHandlerAccess[ddd.Reply](
+			c.Get("createOrderSaga").(sec.Orchestrator[*sagas.CreateOrderData]),
+			"CreateOrderSaga", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
+	container.AddScoped("cancelOrderSagaReplyHandlers", func(c di.Container) (any, error) {
+		return logging.LogReplyHandlerAccess[ddd.Reply](
+			c.Get("cancelOrderSaga").(sec.Orchestrator[*sagas.CancelOrderData]),
+			"CancelOrderSaga", c.Get("logger").(zerolog.Logger),
+		), nil
+	})
 	container.AddScoped("integrationEventHandlers", func(c di.Container) (any, error) {
 		return logging.LogEventHandlerAccess[ddd.Event](
 			handlers.NewIntegrationEventHandlers(
@@ -143,20 +342,35 @@ func (Module) Startup(ctx context.Context, mono ms.Microservice) (err error) {
 	if err = grpc.RegisterServerTx(container, mono.RPC()); err != nil {
 		return err
 	}
-	if err = rest.RegisterGateway(ctx, mono.Mux(), mono.Config().RPC.Address()); err != nil {
+	mono.Mux().Use(gateway.ForwardHeaders)
+	if err = gateway.RegisterGateway(ctx, mono.Mux(), mono.Config().RPC.Address()); err != nil {
 		return err
 	}
 	if err = rest.RegisterSwagger(mono.Mux()); err != nil {
 		return err
 	}
 	handlers.RegisterDomainEventHandlersTx(container)
+	handlers.RegisterSlotEventHandlersTx(container)
+	handlers.RegisterHoldEventHandlersTx(container)
+	handlers.RegisterOrderViewEventHandlersTx(container)
+	handlers.RegisterSagaEventHandlersTx(container)
+	if mono.Config().Expiry.Enabled() {
+		handlers.RegisterDeadlineEventHandlersTx(container)
+	}
 	if err = handlers.RegisterIntegrationEventHandlersTx(container); err != nil {
 		return err
 	}
 	if err = handlers.RegisterCommandHandlersTx(container); err != nil {
 		return err
 	}
+	if err = handlers.RegisterSagaReplyHandlersTx(container); err != nil {
+		return err
+	}
 	startOutboxProcessor(ctx, container)
+	startIdempotencyKeyPurger(ctx, container)
+	if mono.Config().Expiry.Enabled() {
+		startDeadlineProcessor(ctx, container, mono.Config().Expiry)
+	}
 
 	return nil
 }
@@ -176,6 +390,15 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(domain.OrderCreated{}); err != nil {
 		return err
 	}
+	if err = serde.Register(domain.OrderItemAdded{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderItemRemoved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderItemQuantityChanged{}); err != nil {
+		return err
+	}
 	if err = serde.Register(domain.OrderRejected{}); err != nil {
 		return err
 	}
@@ -191,14 +414,138 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(domain.OrderCompleted{}); err != nil {
 		return err
 	}
+	if err = serde.Register(domain.OrderPartiallyRefunded{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderRefunded{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.ReturnRequested{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.ReturnApproved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.ReturnReceived{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.ReturnRejected{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.FulfillmentGroupReadied{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.FulfillmentGroupHandedOver{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderRescheduled{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderExpired{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderPlacedOnHold{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderHoldReleased{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderPaymentConfirmed{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderPaymentFailed{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderCancellationStarted{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderCancellationAborted{}); err != nil {
+		return err
+	}
+	// saga data and the commands the saga sends to this service
+	if err = serde.RegisterKey(sagas.CreateOrderSagaName, sagas.CreateOrderData{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.Refused{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.AuthorizeCustomer{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.ConfirmPayment{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.VoidPayment{}); err != nil {
+		return err
+	}
+	if err = serde.RegisterKey(sagas.CancelOrderSagaName, sagas.CancelOrderData{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.CompleteCancellation{}); err != nil {
+		return err
+	}
+	if err = serde.Register(sagas.AbortCancellation{}); err != nil {
+		return err
+	}
 	// order snapshots
 	if err = serde.RegisterKey(domain.OrderV1{}.SnapshotName(), domain.OrderV1{}); err != nil {
 		return err
 	}
+	if err = serde.RegisterKey(domain.OrderV2{}.SnapshotName(), domain.OrderV2{}); err != nil {
+		return err
+	}
+	if err = serde.RegisterKey(domain.OrderV3{}.SnapshotName(), domain.OrderV3{}); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// eventRegistry is the registry of the event store; it versions the stored payloads
+// of the domain events and upcasts old ones when they are loaded.
+func eventRegistry(reg registry.Registry) (registry.Registry, error) {
+	upcasters := upcasting.NewUpcasters()
+	if err := upcasting.OrderEvents(upcasters); err != nil {
+		return nil, err
+	}
+	return upcasting.NewRegistry(reg, upcasters), nil
+}
+
+func snapshotStrategy(cfg config.SnapshotConfig) postgres.SnapshotStrategy {
+	strategies := []postgres.SnapshotStrategy{postgres.EveryNEvents(cfg.EveryEvents())}
+	if cfg.OnTerminal {
+		strategies = append(strategies, postgres.OnTerminalStatus())
+	}
+	if cfg.MaxAge > 0 {
+		strategies = append(strategies, postgres.OlderThan(cfg.MaxAge))
+	}
+	return postgres.AnyStrategy(strategies...)
+}
+
+func startDeadlineProcessor(ctx context.Context, container di.Container, cfg config.ExpiryConfig) {
+	deadlineProcessor := handlers.NewDeadlineProcessor(container, cfg.PollInterval, cfg.BatchSize)
+	logger := container.Get("logger").(zerolog.Logger)
+
+	go func() {
+		err := deadlineProcessor.Start(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("ordering deadline processor encountered an error")
+		}
+	}()
+}
+
+func startIdempotencyKeyPurger(ctx context.Context, container di.Container) {
+	purger := container.Get("idempotencyKeyPurger").(handlers.IdempotencyKeyPurger)
+	logger := container.Get("logger").(zerolog.Logger)
+
+	go func() {
+		err := purger.Start(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("ordering idempotency key purger encountered an error")
+		}
+	}()
+}
+
 func startOutboxProcessor(ctx context.Context, container di.Container) {
 	outboxProcessor := container.Get("outboxProcessor").(tm.OutboxProcessor)
 	logger := container.Get("logger").(zerolog.Logger)
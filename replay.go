@@ -0,0 +1,84 @@
+package ordering
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+
+	"github.com/stackus/errors"
+
+	"github.com/v8tix/eda/ddd"
+	"github.com/v8tix/eda/es"
+	pg "github.com/v8tix/eda/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/domain"
+	"github.com/v8tix/mallbots-ordering/internal/postgres"
+	"github.com/v8tix/mallbots-ordering/internal/replay"
+)
+
+// Projections are the read models the replay command can rebuild.
+var Projections = []replay.Projection{
+	{
+		Name:  "orders_view",
+		Table: "ordering.orders_view",
+		New: func(db pg.DB, table string) replay.Projector {
+			return orderViewProjector{views: postgres.NewOrderViewRepository(table, db)}
+		},
+	},
+}
+
+type orderViewProjector struct {
+	views domain.OrderViewRepository
+}
+
+func (p orderViewProjector) Project(ctx context.Context, _ ddd.AggregateEvent, order *domain.Order) error {
+	return p.views.Save(ctx, domain.NewOrderView(order))
+}
+
+// Replay rebuilds the named projections, one after the other, from the events in
+// ordering.events.
+func Replay(ctx context.Context, db *sql.DB, out io.Writer, names []string, opts replay.Options) (err error) {
+	projections := make([]replay.Projection, 0, len(names))
+	for _, name := range names {
+		projection, err := findProjection(name)
+		if err != nil {
+			return err
+		}
+		projections = append(projections, projection)
+	}
+
+	reg, events, err := storeRegistries()
+	if err != nil {
+		return err
+	}
+
+	replayer := replay.NewReplayer(db, "ordering.events", "ordering.projection_checkpoints", events,
+		func(tx *sql.Tx) domain.OrderRepository {
+			return postgres.NewOrderRepository(
+				es.NewAggregateRepository[*domain.Order](domain.OrderAggregate, reg, pg.NewEventStore("ordering.events", tx, events)),
+				"ordering.events", "ordering.snapshots", tx, reg, events,
+			)
+		},
+		out,
+	)
+
+	for _, projection := range projections {
+		if err = replayer.Rebuild(ctx, projection, opts); err != nil {
+			return errors.Wrapf(err, "rebuilding %s", projection.Name)
+		}
+	}
+
+	return nil
+}
+
+func findProjection(name string) (replay.Projection, error) {
+	names := make([]string, len(Projections))
+	for i, projection := range Projections {
+		if projection.Name == name {
+			return projection, nil
+		}
+		names[i] = projection.Name
+	}
+
+	return replay.Projection{}, errors.Wrapf(errors.ErrInvalidArgument, "unknown projection %q; known projections: %s", name, strings.Join(names, ", "))
+}